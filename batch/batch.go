@@ -0,0 +1,292 @@
+package batch
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	contestpaintingeffects "github.com/huderlem/contest-painting-effects"
+	"github.com/huderlem/contest-painting-effects/canvas"
+)
+
+// ManifestFile is the name of the manifest written to the output directory.
+const ManifestFile = "manifest.json"
+
+// NumCoolPersonalities is the number of personality values processed for the
+// Cool category. Personality values 0 through 17 cover every color that the
+// dark pixels of Cool paintings are drawn with: six hues, each with three
+// strengths.
+const NumCoolPersonalities = 18
+
+// imageExtensions are the file extensions of images that are processed.
+var imageExtensions = map[string]bool{
+	".png":  true,
+	".gif":  true,
+	".jpg":  true,
+	".jpeg": true,
+}
+
+// Options configures a batch run.
+type Options struct {
+	// Workers is the maximum number of images processed at the same time.
+	// Defaults to the number of CPUs.
+	Workers int
+	// Indexed writes indexed PNGs that keep the quantized palette order,
+	// instead of RGBA PNGs.
+	Indexed bool
+}
+
+// Manifest describes the outputs of a batch run.
+type Manifest struct {
+	Entries []Entry `json:"entries"`
+}
+
+// Entry describes one painting produced from a source image. Paths are
+// relative to the source and output directories.
+type Entry struct {
+	Source      string                          `json:"source"`
+	Category    contestpaintingeffects.Category `json:"category"`
+	Personality *uint8                          `json:"personality,omitempty"`
+	Output      string                          `json:"output,omitempty"`
+	Error       string                          `json:"error,omitempty"`
+}
+
+// Failed returns the number of entries that could not be produced.
+func (m *Manifest) Failed() int {
+	failed := 0
+	for _, entry := range m.Entries {
+		if entry.Error != "" {
+			failed++
+		}
+	}
+	return failed
+}
+
+// ProcessDir applies every contest category to each image found under srcDir,
+// and writes the paintings to outDir. Cool paintings are produced for every
+// personality color. The output tree looks like:
+//
+//	outDir/beauty/<source>.png
+//	outDir/cool/personality-05/<source>.png
+//	outDir/manifest.json
+//
+// Images that fail to process are recorded in the manifest's entries, rather
+// than stopping the run. Images whose names only differ by extension, like
+// foo.png and foo.gif, would be written to the same outputs, so they are not
+// processed, and are recorded as errors. The returned error is only non-nil
+// if the run itself could not complete.
+func ProcessDir(srcDir, outDir string, opts Options) (*Manifest, error) {
+	sources, err := findImages(srcDir, outDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return nil, err
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	collisions := findCollisions(sources)
+	results := make([][]Entry, len(sources))
+	runJobs(len(sources), workers, func(job int) {
+		if err, ok := collisions[sources[job]]; ok {
+			results[job] = []Entry{{Source: filepath.ToSlash(sources[job]), Error: err}}
+			return
+		}
+		results[job] = processImage(srcDir, sources[job], outDir, opts)
+	})
+
+	manifest := &Manifest{Entries: []Entry{}}
+	for _, entries := range results {
+		manifest.Entries = append(manifest.Entries, entries...)
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(outDir, ManifestFile), data, 0644); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// runJobs calls do for each job from 0 to numJobs-1, with at most workers
+// calls running at the same time. It returns once every job is done.
+func runJobs(numJobs, workers int, do func(job int)) {
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				do(job)
+			}
+		}()
+	}
+	for i := 0; i < numJobs; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// findImages returns the sorted paths, relative to srcDir, of all images under
+// srcDir. The output directory is skipped, in case it is inside srcDir.
+func findImages(srcDir, outDir string) ([]string, error) {
+	absOutDir, err := filepath.Abs(outDir)
+	if err != nil {
+		return nil, err
+	}
+	var sources []string
+	err = filepath.Walk(srcDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if absPath, err := filepath.Abs(path); err == nil && absPath == absOutDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !imageExtensions[strings.ToLower(filepath.Ext(path))] {
+			return nil
+		}
+		relPath, err := filepath.Rel(srcDir, path)
+		if err != nil {
+			return err
+		}
+		sources = append(sources, relPath)
+		return nil
+	})
+	sort.Strings(sources)
+	return sources, err
+}
+
+// outputName returns the file name of a source image's paintings, relative
+// to each category directory.
+func outputName(source string) string {
+	return strings.TrimSuffix(source, filepath.Ext(source)) + ".png"
+}
+
+// findCollisions returns an error message for each source whose paintings
+// would be written to the same files as another source's.
+func findCollisions(sources []string) map[string]string {
+	byName := make(map[string][]string)
+	for _, source := range sources {
+		name := outputName(source)
+		byName[name] = append(byName[name], source)
+	}
+	collisions := make(map[string]string)
+	for name, group := range byName {
+		if len(group) < 2 {
+			continue
+		}
+		slashed := make([]string, len(group))
+		for i, source := range group {
+			slashed[i] = filepath.ToSlash(source)
+		}
+		message := fmt.Sprintf("sources %s would all be written to %s", strings.Join(slashed, ", "), filepath.ToSlash(name))
+		for _, source := range group {
+			collisions[source] = message
+		}
+	}
+	return collisions
+}
+
+// processImage produces every painting for a single source image.
+func processImage(srcDir, source, outDir string, opts Options) []Entry {
+	var entries []Entry
+	img, err := loadImage(filepath.Join(srcDir, source))
+	if err != nil {
+		return []Entry{{Source: filepath.ToSlash(source), Error: err.Error()}}
+	}
+	src := canvas.FromImage(img)
+	name := outputName(source)
+	for _, category := range contestpaintingeffects.Categories() {
+		if category != contestpaintingeffects.Cool {
+			output := filepath.Join(category.String(), name)
+			entries = append(entries, paint(src, source, category, nil, outDir, output, opts))
+			continue
+		}
+		for p := 0; p < NumCoolPersonalities; p++ {
+			personality := uint8(p)
+			output := filepath.Join(category.String(), fmt.Sprintf("personality-%02d", p), name)
+			entries = append(entries, paint(src, source, category, &personality, outDir, output, opts))
+		}
+	}
+	return entries
+}
+
+// paint applies a category to a copy of the source canvas, and saves the
+// painting.
+func paint(src canvas.Canvas, source string, category contestpaintingeffects.Category, personality *uint8, outDir, output string, opts Options) Entry {
+	entry := Entry{
+		Source:      filepath.ToSlash(source),
+		Category:    category,
+		Personality: personality,
+	}
+	c := src.Clone()
+	var options contestpaintingeffects.Options
+	if personality != nil {
+		options.Personality = *personality
+	}
+	palette, err := contestpaintingeffects.Apply(c, category, options)
+	if err != nil {
+		entry.Error = err.Error()
+		return entry
+	}
+	var painting image.Image
+	if opts.Indexed {
+		painting = c.ToPaletted(palette)
+	} else {
+		painting = c.ToImage(palette)
+	}
+	if err := savePNG(filepath.Join(outDir, output), painting); err != nil {
+		entry.Error = err.Error()
+		return entry
+	}
+	entry.Output = filepath.ToSlash(output)
+	return entry
+}
+
+func loadImage(path string) (image.Image, error) {
+	imageFile, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening input image file: %s", err)
+	}
+	defer imageFile.Close()
+
+	img, _, err := image.Decode(imageFile)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding image file %s: %s", path, err)
+	}
+	return img, nil
+}
+
+func savePNG(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	outputFile, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error saving image: %s", err)
+	}
+	if err := png.Encode(outputFile, img); err != nil {
+		outputFile.Close()
+		return fmt.Errorf("error encoding image %s: %s", path, err)
+	}
+	return outputFile.Close()
+}
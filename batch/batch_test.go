@@ -0,0 +1,191 @@
+package batch
+
+import (
+	"encoding/json"
+	"image/gif"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	contestpaintingeffects "github.com/huderlem/contest-painting-effects"
+	"github.com/huderlem/contest-painting-effects/canvas"
+	"github.com/huderlem/contest-painting-effects/pixelq"
+)
+
+func TestRunJobs(t *testing.T) {
+	const numJobs, workers = 40, 3
+	var mu sync.Mutex
+	active, maxActive := 0, 0
+	done := make([]int, numJobs)
+	runJobs(numJobs, workers, func(job int) {
+		mu.Lock()
+		active++
+		if active > maxActive {
+			maxActive = active
+		}
+		done[job]++
+		mu.Unlock()
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		active--
+		mu.Unlock()
+	})
+	if maxActive > workers {
+		t.Errorf("%d jobs ran at the same time, want at most %d", maxActive, workers)
+	}
+	for job, n := range done {
+		if n != 1 {
+			t.Errorf("job %d ran %d times, want once", job, n)
+		}
+	}
+}
+
+func copyFile(t *testing.T, src, dst string) {
+	data, err := ioutil.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(dst, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProcessDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "batch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	srcDir := filepath.Join(dir, "sprites")
+	outDir := filepath.Join(dir, "paintings")
+	copyFile(t, "../testdata/sprites/charizard.png", filepath.Join(srcDir, "charizard.png"))
+	copyFile(t, "../testdata/sprites/arcanine.png", filepath.Join(srcDir, "fire", "arcanine.png"))
+	if err := ioutil.WriteFile(filepath.Join(srcDir, "broken.png"), []byte("not a png"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(srcDir, "notes.txt"), []byte("skipped"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, err := ProcessDir(srcDir, outDir, Options{Workers: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each image gets one painting per category, and one per personality
+	// for Cool. Entries are ordered by source, whatever order the workers
+	// finish in. The broken image only gets an error entry.
+	perImage := len(contestpaintingeffects.Categories()) - 1 + NumCoolPersonalities
+	if want := 1 + 2*perImage; len(manifest.Entries) != want {
+		t.Fatalf("manifest has %d entries, want %d", len(manifest.Entries), want)
+	}
+	broken := manifest.Entries[0]
+	if broken.Source != "broken.png" || broken.Error == "" || broken.Output != "" {
+		t.Errorf("first entry is %+v, want an error for broken.png", broken)
+	}
+	if manifest.Failed() != 1 {
+		t.Errorf("Failed() = %d, want 1", manifest.Failed())
+	}
+	for i, entry := range manifest.Entries[1:] {
+		want := "charizard.png"
+		if i >= perImage {
+			want = "fire/arcanine.png"
+		}
+		if entry.Source != want || entry.Error != "" {
+			t.Fatalf("entry %d is %+v, want a painting of %s", i+1, entry, want)
+		}
+		if _, err := os.Stat(filepath.Join(outDir, filepath.FromSlash(entry.Output))); err != nil {
+			t.Errorf("missing output: %s", err)
+		}
+	}
+	if got := manifest.Entries[2].Output; got != "cool/personality-01/charizard.png" {
+		t.Errorf("second Cool painting is %s, want cool/personality-01/charizard.png", got)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(outDir, ManifestFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var written Manifest
+	if err := json.Unmarshal(data, &written); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&written, manifest) {
+		t.Errorf("%s does not match the returned manifest", ManifestFile)
+	}
+
+	if _, err := ProcessDir(filepath.Join(dir, "missing"), outDir, Options{}); err == nil {
+		t.Errorf("missing source directory: got no error")
+	}
+}
+
+func TestProcessDirOutputCollision(t *testing.T) {
+	dir, err := ioutil.TempDir("", "batch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	srcDir := filepath.Join(dir, "sprites")
+	outDir := filepath.Join(dir, "paintings")
+	copyFile(t, "../testdata/sprites/charizard.png", filepath.Join(srcDir, "charizard.png"))
+	copyFile(t, "../testdata/sprites/arcanine.png", filepath.Join(srcDir, "foo.png"))
+	img, err := loadImage("../testdata/sprites/arcanine.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Create(filepath.Join(srcDir, "foo.gif"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := gif.Encode(f, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	manifest, err := ProcessDir(srcDir, outDir, Options{Workers: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	perImage := len(contestpaintingeffects.Categories()) - 1 + NumCoolPersonalities
+	if want := perImage + 2; len(manifest.Entries) != want {
+		t.Fatalf("manifest has %d entries, want %d", len(manifest.Entries), want)
+	}
+	// The colliding sources sort after charizard.png.
+	for i, source := range []string{"foo.gif", "foo.png"} {
+		entry := manifest.Entries[perImage+i]
+		if entry.Source != source || !strings.Contains(entry.Error, "foo.gif, foo.png") {
+			t.Errorf("entry %d is %+v, want a collision error for %s", perImage+i, entry, source)
+		}
+	}
+	if manifest.Failed() != 2 {
+		t.Errorf("Failed() = %d, want 2", manifest.Failed())
+	}
+	if _, err := os.Stat(filepath.Join(outDir, "beauty", "foo.png")); !os.IsNotExist(err) {
+		t.Errorf("colliding sources were painted: %v", err)
+	}
+}
+
+func TestCoolPersonalitiesDistinct(t *testing.T) {
+	// Personality values repeat every NumCoolPersonalities values, and each
+	// value in between gives dark pixels a different color.
+	dark := canvas.RGB555{A: 255}
+	seen := make(map[canvas.RGB555]int)
+	for p := 0; p < NumCoolPersonalities; p++ {
+		color := pixelq.PersonalityColor(dark, uint8(p))
+		if other, ok := seen[color]; ok {
+			t.Errorf("personalities %d and %d both give %v", other, p, color)
+		}
+		seen[color] = p
+		if repeat := pixelq.PersonalityColor(dark, uint8(p+NumCoolPersonalities)); repeat != color {
+			t.Errorf("personality %d gives %v, want the same color as personality %d, %v", p+NumCoolPersonalities, repeat, p, color)
+		}
+	}
+}
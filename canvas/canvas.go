@@ -1,116 +1,270 @@
 package canvas
 
 import (
+	"fmt"
 	"image"
 	"image/color"
 )
 
+// MaxPaletteSize is the largest number of colors a canvas palette can
+// have. Color indexes are stored in a single byte, so they range from 0 to
+// MaxPaletteSize-1.
+const MaxPaletteSize = 256
+
 // Canvas is a representation of the image processing canvas.
-// Holds an internal state of pixels.
+// Holds an internal state of pixels. Pixels are addressed with the
+// same coordinates as the canvas bounds, which do not need to start
+// at (0, 0).
+//
+// Copying a Canvas value does not copy its pixels: both copies share the
+// same pixel buffer, and effects applied to one are visible in the other.
+// Use Clone to make an independent copy.
 type Canvas struct {
-	pixels       []color.RGBA
-	pixelIndexes []int
-	width        int
-	height       int
+	pixels       []RGB555
+	pixelIndexes []uint8
+	bounds       image.Rectangle
+	// stride is the distance between vertically adjacent pixels in the
+	// pixel slices. It is wider than the bounds for sub-canvases.
+	stride      int
+	channelMode ChannelMode
 }
 
-// FromImage builds a new Canvas from a given image.
+// FromImage builds a new Canvas from a given image. The canvas has the
+// same bounds as the image. Color channels are converted with ConvertFloor.
 func FromImage(imageData image.Image) Canvas {
-	bounds := imageData.Bounds()
-	c := New(bounds.Max.X, bounds.Max.Y)
-	for x := 0; x < bounds.Max.X; x++ {
-		for y := 0; y < bounds.Max.Y; y++ {
-			r, g, b, a := imageData.At(x, y).RGBA()
-			convertedRed := uint8(r) / 8
-			convertedGreen := uint8(g) / 8
-			convertedBlue := uint8(b) / 8
-			convertedAlpha := uint8(a)
-			if convertedAlpha != 255 {
-				convertedAlpha = 0
-			}
-			c.Set(x, y, color.RGBA{convertedRed, convertedGreen, convertedBlue, convertedAlpha})
-		}
-	}
-	return c
+	return FromImageWithOptions(imageData, FromImageOptions{})
 }
 
-// New creates a new pixel Canvas.
+// New creates a new pixel Canvas, with its top-left corner at (0, 0).
 func New(width, height int) Canvas {
+	return NewRect(image.Rect(0, 0, width, height))
+}
+
+// NewRect creates a new pixel Canvas with the given bounds.
+func NewRect(bounds image.Rectangle) Canvas {
+	bounds = bounds.Canon()
 	return Canvas{
-		pixels:       make([]color.RGBA, width*height),
-		pixelIndexes: make([]int, width*height),
-		width:        width,
-		height:       height,
+		pixels:       make([]RGB555, bounds.Dx()*bounds.Dy()),
+		pixelIndexes: make([]uint8, bounds.Dx()*bounds.Dy()),
+		bounds:       bounds,
+		stride:       bounds.Dx(),
 	}
 }
 
 // Width returns the canvas width in pixels.
 func (c *Canvas) Width() int {
-	return c.width
+	return c.bounds.Dx()
 }
 
 // Height returns the canvas height in pixels.
 func (c *Canvas) Height() int {
-	return c.height
+	return c.bounds.Dy()
+}
+
+// Bounds returns the rectangle covered by the canvas.
+func (c *Canvas) Bounds() image.Rectangle {
+	return c.bounds
 }
 
-// At returns the color of a pixel.
-func (c *Canvas) At(x, y int) color.RGBA {
-	if x < 0 || x >= c.width || y < 0 || y >= c.height {
-		return color.RGBA{}
+// offset returns the position of a pixel in the pixel slices, and
+// whether the pixel is inside the canvas.
+func (c *Canvas) offset(x, y int) (int, bool) {
+	if !(image.Point{X: x, Y: y}.In(c.bounds)) {
+		return 0, false
 	}
-	rowOffset := y * c.width
-	return c.pixels[rowOffset+x]
+	return (y-c.bounds.Min.Y)*c.stride + (x - c.bounds.Min.X), true
+}
+
+// Clone returns a copy of the canvas, with its own pixel buffer. The copy
+// has the same bounds, pixel colors and color indexes.
+func (c *Canvas) Clone() Canvas {
+	clone := NewRect(c.bounds)
+	clone.channelMode = c.channelMode
+	clone.CopyFrom(*c)
+	return clone
+}
+
+// CopyFrom copies the pixel colors and color indexes of src into the
+// canvas, where their bounds overlap. Pixels outside of src are left
+// unchanged.
+func (c *Canvas) CopyFrom(src Canvas) {
+	r := c.bounds.Intersect(src.bounds)
+	if r.Empty() {
+		return
+	}
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		i, _ := c.offset(r.Min.X, y)
+		j, _ := src.offset(r.Min.X, y)
+		copy(c.pixels[i:i+r.Dx()], src.pixels[j:j+r.Dx()])
+		copy(c.pixelIndexes[i:i+r.Dx()], src.pixelIndexes[j:j+r.Dx()])
+	}
+}
+
+// SubCanvas returns a canvas covering the part of the canvas inside rect.
+// It is a view: it shares pixels with the original canvas, so effects
+// applied to the sub-canvas change the original canvas too. The
+// sub-canvas keeps the original coordinates.
+func (c *Canvas) SubCanvas(rect image.Rectangle) Canvas {
+	r := rect.Intersect(c.bounds)
+	if r.Empty() {
+		return Canvas{}
+	}
+	i, _ := c.offset(r.Min.X, r.Min.Y)
+	return Canvas{
+		pixels:       c.pixels[i:],
+		pixelIndexes: c.pixelIndexes[i:],
+		bounds:       r,
+		stride:       c.stride,
+		channelMode:  c.channelMode,
+	}
+}
+
+// Region returns the area of the canvas to process, like the game's
+// column/row start and end. If a region is given, it is clipped to the
+// canvas bounds. Otherwise, the whole canvas is returned. Only the first
+// region is used. Some effects need a minimum region size, like the
+// pointillism effect, which only draws dots on whole 64x64 tiles.
+func (c *Canvas) Region(region ...image.Rectangle) image.Rectangle {
+	if len(region) == 0 {
+		return c.Bounds()
+	}
+	return region[0].Intersect(c.Bounds())
+}
+
+// Pixel returns the color of a pixel.
+func (c *Canvas) Pixel(x, y int) RGB555 {
+	i, ok := c.offset(x, y)
+	if !ok {
+		return RGB555{}
+	}
+	return c.pixels[i]
 }
 
 // AtColorIndex returns the color index of a pixel.
 func (c *Canvas) AtColorIndex(x, y int) int {
-	if x < 0 || x >= c.width || y < 0 || y >= c.height {
+	i, ok := c.offset(x, y)
+	if !ok {
 		return 0
 	}
-	rowOffset := y * c.width
-	return c.pixelIndexes[rowOffset+x]
+	return int(c.pixelIndexes[i])
 }
 
-// Set a pixel color.
-func (c *Canvas) Set(x, y int, color color.RGBA) {
-	if x < 0 || x >= c.width || y < 0 || y >= c.height {
-		return
+// SetPixel sets the color of a pixel. Colors with channels outside of the
+// range 0-31 are handled according to the canvas's channel mode. It only
+// returns an error in ChannelsStrict mode.
+func (c *Canvas) SetPixel(x, y int, color RGB555) error {
+	i, ok := c.offset(x, y)
+	if !ok {
+		return nil
 	}
-	rowOffset := y * c.width
-	c.pixels[rowOffset+x] = color
+	if !inRange(color) {
+		switch c.channelMode {
+		case ChannelsClamp:
+			color = clamp(color)
+		case ChannelsStrict:
+			return &ChannelError{X: x, Y: y, Color: color}
+		}
+	}
+	c.pixels[i] = color
+	return nil
 }
 
-// SetColorIndex the color index for a pixel.
-func (c *Canvas) SetColorIndex(x, y, index int) {
-	if x < 0 || x >= c.width || y < 0 || y >= c.height {
-		return
+// ColorModel returns RGB555Model. It is part of the image.Image interface.
+func (c *Canvas) ColorModel() color.Model {
+	return RGB555Model
+}
+
+// At returns the color of a pixel. It is part of the image.Image interface,
+// so a Canvas can be passed to image/draw or encoded directly.
+func (c *Canvas) At(x, y int) color.Color {
+	return c.Pixel(x, y)
+}
+
+// Set sets the color of a pixel, converting it with RGB555Model. It is part
+// of the draw.Image interface.
+//
+// Set treats the color as a normal 8-bit color, so
+// Set(x, y, color.RGBA{31, 31, 31, 255}) stores a dark gray, {3, 3, 3}.
+// Before Canvas implemented draw.Image, Set stored 5-bit channels as they
+// were. Use SetPixel to store 5-bit channels.
+func (c *Canvas) Set(x, y int, col color.Color) {
+	c.SetPixel(x, y, RGB555Model.Convert(col).(RGB555))
+}
+
+// SetColorIndex the color index for a pixel. It returns an error, and
+// leaves the pixel unchanged, if the index does not fit in a palette of
+// MaxPaletteSize colors.
+func (c *Canvas) SetColorIndex(x, y, index int) error {
+	if index < 0 || index >= MaxPaletteSize {
+		return fmt.Errorf("color index %d is out of range 0-%d", index, MaxPaletteSize-1)
+	}
+	i, ok := c.offset(x, y)
+	if !ok {
+		return nil
+	}
+	c.pixelIndexes[i] = uint8(index)
+	return nil
+}
+
+// ToImage returns an image representation of the Canvas, with the same
+// bounds as the canvas. Colors are expanded with ExpandShift.
+func (c *Canvas) ToImage(palette []RGB555) image.Image {
+	return c.ToImageWithOptions(palette, ToImageOptions{})
+}
+
+// ToImageWithOptions returns an image representation of the Canvas, using
+// the given options. The image has the same bounds as the canvas.
+func (c *Canvas) ToImageWithOptions(palette []RGB555, opts ToImageOptions) *image.RGBA {
+	imagePalette := make([]color.RGBA, len(palette))
+	for i, paletteColor := range palette {
+		imagePalette[i] = opts.ColorExpansion.Expand(paletteColor)
 	}
-	rowOffset := y * c.width
-	c.pixelIndexes[rowOffset+x] = index
+	img := image.NewRGBA(c.bounds)
+	for y := c.bounds.Min.Y; y < c.bounds.Max.Y; y++ {
+		for x := c.bounds.Min.X; x < c.bounds.Max.X; x++ {
+			pixelIndex := c.AtColorIndex(x, y)
+			if pixelIndex >= 0 && pixelIndex < len(imagePalette) {
+				img.SetRGBA(x, y, imagePalette[pixelIndex])
+			}
+		}
+	}
+	return img
 }
 
-// ToImage returns an image representation of the Canvas.
-func (c *Canvas) ToImage(palette []color.RGBA) image.Image {
-	img := image.NewRGBA(image.Rectangle{
-		Min: image.Point{X: 0, Y: 0},
-		Max: image.Point{X: c.width, Y: c.height},
-	})
-	for x := 0; x < c.width; x++ {
-		for y := 0; y < c.height; y++ {
+// ToPaletted returns an indexed image representation of the Canvas, with
+// the same bounds as the canvas. The image's palette is the given palette,
+// and its pixels are the canvas color indexes, so the quantized palette
+// order is preserved. Index 0 is always transparent. Pixels whose color
+// index is outside of the palette are set to index 0. Palettes can have at
+// most MaxPaletteSize colors, so any extra colors are dropped. Colors are
+// expanded with ExpandShift.
+func (c *Canvas) ToPaletted(palette []RGB555) *image.Paletted {
+	return c.ToPalettedWithOptions(palette, ToImageOptions{})
+}
+
+// ToPalettedWithOptions is like ToPaletted, but expands the palette colors
+// using the given options.
+func (c *Canvas) ToPalettedWithOptions(palette []RGB555, opts ToImageOptions) *image.Paletted {
+	if len(palette) > MaxPaletteSize {
+		palette = palette[:MaxPaletteSize]
+	}
+	if len(palette) == 0 {
+		palette = []RGB555{{}}
+	}
+	imagePalette := make(color.Palette, len(palette))
+	for i, paletteColor := range palette {
+		if i == 0 {
+			imagePalette[i] = color.RGBA{}
+		} else {
+			imagePalette[i] = opts.ColorExpansion.Expand(paletteColor)
+		}
+	}
+	img := image.NewPaletted(c.bounds, imagePalette)
+	for y := c.bounds.Min.Y; y < c.bounds.Max.Y; y++ {
+		for x := c.bounds.Min.X; x < c.bounds.Max.X; x++ {
 			pixelIndex := c.AtColorIndex(x, y)
 			if pixelIndex >= 0 && pixelIndex < len(palette) {
-				paletteColor := palette[pixelIndex]
-				r := paletteColor.R * 8
-				g := paletteColor.G * 8
-				b := paletteColor.B * 8
-				a := paletteColor.A
-				if a != 255 {
-					a = 0
-				}
-				img.Set(x, y, color.RGBA{r, g, b, a})
-			} else {
-				img.Set(x, y, color.RGBA{})
+				img.SetColorIndex(x, y, uint8(pixelIndex))
 			}
 		}
 	}
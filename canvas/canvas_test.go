@@ -0,0 +1,342 @@
+package canvas
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"testing"
+)
+
+func TestFromImageSubImageRoundTrip(t *testing.T) {
+	sheet := image.NewRGBA(image.Rect(0, 0, 32, 32))
+	for y := 0; y < 32; y++ {
+		for x := 0; x < 32; x++ {
+			sheet.Set(x, y, color.RGBA{uint8(x * 8), uint8(y * 8), 0, 255})
+		}
+	}
+	sub := sheet.SubImage(image.Rect(8, 16, 24, 32))
+
+	c := FromImage(sub)
+	if c.Bounds() != sub.Bounds() {
+		t.Fatalf("canvas bounds are %v, want %v", c.Bounds(), sub.Bounds())
+	}
+	if got, want := c.Pixel(8, 16), (RGB555{8, 16, 0, 255}); got != want {
+		t.Errorf("At(8, 16) = %v, want %v", got, want)
+	}
+	if got := c.Pixel(0, 0); got != (RGB555{}) {
+		t.Errorf("At(0, 0) outside the bounds = %v, want transparent", got)
+	}
+
+	palette := []RGB555{{}, {R: 1, G: 2, B: 3, A: 255}}
+	c.SetColorIndex(10, 20, 1)
+	for _, img := range []image.Image{c.ToImage(palette), c.ToPaletted(palette)} {
+		if img.Bounds() != sub.Bounds() {
+			t.Errorf("%T bounds are %v, want %v", img, img.Bounds(), sub.Bounds())
+		}
+		if _, _, _, a := img.At(10, 20).RGBA(); a == 0 {
+			t.Errorf("%T pixel (10, 20) is transparent, want opaque", img)
+		}
+	}
+}
+
+func TestToPaletted(t *testing.T) {
+	// Index 0 is opaque in the palette, but is still written as
+	// transparent, and index 5 is outside of the palette.
+	palette := []RGB555{{R: 31, A: 255}, {R: 1, G: 2, B: 3, A: 255}, {R: 31, G: 31, B: 31, A: 255}}
+	indexes := []uint8{
+		0, 1, 2, 1,
+		2, 2, 5, 0,
+		1, 0, 0, 2,
+	}
+	c := New(4, 3)
+	for i, index := range indexes {
+		c.SetColorIndex(i%4, i/4, int(index))
+	}
+
+	img := c.ToPaletted(palette)
+	want := append([]uint8(nil), indexes...)
+	want[6] = 0
+	if !bytes.Equal(img.Pix, want) {
+		t.Errorf("Pix = %v, want %v", img.Pix, want)
+	}
+	if len(img.Palette) != len(palette) {
+		t.Fatalf("palette has %d colors, want %d", len(img.Palette), len(palette))
+	}
+	if got := img.Palette[0]; got != (color.RGBA{}) {
+		t.Errorf("Palette[0] = %v, want transparent", got)
+	}
+	if got, want := img.Palette[1], (color.RGBA{8, 16, 24, 255}); got != want {
+		t.Errorf("Palette[1] = %v, want %v", got, want)
+	}
+}
+
+func TestFromImageColorConversion(t *testing.T) {
+	tests := []struct {
+		conversion ColorConversion
+		in         color.Color
+		want       uint8
+	}{
+		{ConvertFloor, color.RGBA{255, 255, 255, 255}, 31},
+		{ConvertFloor, color.RGBA{15, 15, 15, 255}, 1},
+		{ConvertFloor, color.RGBA64{0x0FFF, 0x0FFF, 0x0FFF, 0xFFFF}, 1},
+		{ConvertRound, color.RGBA{15, 15, 15, 255}, 2},
+		{ConvertRound, color.RGBA{255, 255, 255, 255}, 31},
+		{ConvertGBA, color.RGBA{255, 255, 255, 255}, 31},
+		{ConvertGBA, color.RGBA{132, 132, 132, 255}, 16},
+		{ConvertGBA, color.RGBA{123, 123, 123, 255}, 15},
+		{ConvertPassthrough, color.RGBA{17, 17, 17, 255}, 17},
+	}
+	for _, tt := range tests {
+		img := image.NewRGBA64(image.Rect(0, 0, 1, 1))
+		img.Set(0, 0, tt.in)
+		c := FromImageWithOptions(img, FromImageOptions{ColorConversion: tt.conversion})
+		if got := c.Pixel(0, 0); got.R != tt.want || got.G != tt.want || got.B != tt.want {
+			t.Errorf("conversion %d of %v = %v, want channels of %d", tt.conversion, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestToImageColorExpansion(t *testing.T) {
+	tests := []struct {
+		expansion ColorExpansion
+		in        uint8
+		want      uint8
+	}{
+		{ExpandShift, 31, 248},
+		{ExpandShift, 1, 8},
+		{ExpandReplicate, 31, 255},
+		{ExpandReplicate, 16, 132},
+		{ExpandReplicate, 0, 0},
+		{ExpandGBALCD, 0, 0},
+	}
+	for _, tt := range tests {
+		c := New(1, 1)
+		c.SetColorIndex(0, 0, 1)
+		palette := []RGB555{{}, {tt.in, tt.in, tt.in, 255}}
+		opts := ToImageOptions{ColorExpansion: tt.expansion}
+		got := c.ToImageWithOptions(palette, opts).RGBAAt(0, 0)
+		if got.R != tt.want || got.G != tt.want || got.B != tt.want || got.A != 255 {
+			t.Errorf("expansion %d of %d = %v, want channels of %d", tt.expansion, tt.in, got, tt.want)
+		}
+		if got := c.ToPalettedWithOptions(palette, opts).Palette[1]; got != (color.RGBA{tt.want, tt.want, tt.want, 255}) {
+			t.Errorf("paletted expansion %d of %d = %v, want channels of %d", tt.expansion, tt.in, got, tt.want)
+		}
+	}
+
+	// The LCD correction mutes colors, so pure red is no longer pure.
+	red := ExpandGBALCD.Expand(RGB555{31, 0, 0, 255})
+	if red.R < 200 || red.G == 0 || red.B == 0 {
+		t.Errorf("LCD-corrected red = %v, want a muted red", red)
+	}
+}
+
+func TestFromImageAlphaMode(t *testing.T) {
+	halfRed := color.NRGBA{255, 0, 0, 128}
+	magenta := color.NRGBA{255, 0, 255, 255}
+	tests := []struct {
+		name string
+		opts FromImageOptions
+		in   color.Color
+		want RGB555
+	}{
+		{"opaque only", FromImageOptions{}, halfRed, RGB555{16, 0, 0, 0}},
+		{"threshold kept", FromImageOptions{AlphaMode: AlphaThreshold, AlphaThreshold: 128}, halfRed, RGB555{31, 0, 0, 255}},
+		{"threshold dropped", FromImageOptions{AlphaMode: AlphaThreshold, AlphaThreshold: 129}, halfRed, RGB555{16, 0, 0, 0}},
+		{"matte black", FromImageOptions{AlphaMode: AlphaMatte}, halfRed, RGB555{16, 0, 0, 255}},
+		{"matte white", FromImageOptions{AlphaMode: AlphaMatte, Matte: color.White}, halfRed, RGB555{31, 15, 15, 255}},
+		{"matte transparent", FromImageOptions{AlphaMode: AlphaMatte, Matte: color.White}, color.NRGBA{}, RGB555{31, 31, 31, 255}},
+		{"color key match", FromImageOptions{AlphaMode: AlphaColorKey, ColorKey: magenta}, magenta, RGB555{31, 0, 31, 0}},
+		{"color key other", FromImageOptions{AlphaMode: AlphaColorKey, ColorKey: magenta}, halfRed, RGB555{31, 0, 0, 255}},
+		{"color key transparent", FromImageOptions{AlphaMode: AlphaColorKey, ColorKey: magenta}, color.NRGBA{}, RGB555{}},
+	}
+	for _, tt := range tests {
+		img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+		img.Set(0, 0, tt.in)
+		c := FromImageWithOptions(img, tt.opts)
+		if got := c.Pixel(0, 0); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+var _ draw.Image = (*Canvas)(nil)
+
+func TestCanvasDrawImage(t *testing.T) {
+	c := NewRect(image.Rect(4, 4, 12, 12))
+	draw.Draw(&c, image.Rect(4, 4, 8, 8), image.NewUniform(color.RGBA{255, 128, 0, 255}), image.Point{}, draw.Src)
+	if got, want := c.Pixel(5, 5), (RGB555{31, 16, 0, 255}); got != want {
+		t.Errorf("Pixel(5, 5) after drawing = %v, want %v", got, want)
+	}
+	if got, want := color.RGBAModel.Convert(c.At(5, 5)), (color.RGBA{248, 128, 0, 255}); got != want {
+		t.Errorf("At(5, 5) = %v, want %v", got, want)
+	}
+	if _, _, _, a := c.At(10, 10).RGBA(); a != 0 {
+		t.Errorf("At(10, 10) = %v, want transparent", c.At(10, 10))
+	}
+
+	// Converting a canvas color back through the model is lossless.
+	expanded := color.RGBAModel.Convert(c.At(5, 5))
+	if got := RGB555Model.Convert(expanded); got != c.At(5, 5) {
+		t.Errorf("RGB555Model.Convert(%v) = %v, want %v", expanded, got, c.At(5, 5))
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, &c); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// PNG files do not store the image origin, so (5, 5) is at (1, 1).
+	roundTrip := FromImage(decoded)
+	if got := roundTrip.Pixel(1, 1); got != c.Pixel(5, 5) {
+		t.Errorf("decoded Pixel(1, 1) = %v, want %v", got, c.Pixel(5, 5))
+	}
+}
+
+func TestRGB555(t *testing.T) {
+	c := RGB555{R: 31, G: 16, B: 1, A: 255}
+	if got, want := c.BGR555(), uint16(0x061F); got != want {
+		t.Errorf("BGR555() = %#04x, want %#04x", got, want)
+	}
+	if got := FromBGR555(0x861F); got != c {
+		t.Errorf("FromBGR555(0x861F) = %v, want %v", got, c)
+	}
+	if r, g, b, a := c.RGBA(); r != 248*0x101 || g != 128*0x101 || b != 8*0x101 || a != 0xFFFF {
+		t.Errorf("RGBA() = %#x %#x %#x %#x, want 8-bit channels 248 128 8 255", r, g, b, a)
+	}
+	if _, _, _, a := (RGB555{R: 31}).RGBA(); a != 0 {
+		t.Errorf("transparent RGBA() alpha = %#x, want 0", a)
+	}
+}
+
+func TestCloneCopyFromSubCanvas(t *testing.T) {
+	c := NewRect(image.Rect(2, 2, 10, 10))
+	for y := 2; y < 10; y++ {
+		for x := 2; x < 10; x++ {
+			c.SetPixel(x, y, RGB555{uint8(x), uint8(y), 0, 255})
+			c.SetColorIndex(x, y, x+y)
+		}
+	}
+
+	clone := c.Clone()
+	clone.SetPixel(3, 3, RGB555{})
+	if c.Pixel(3, 3) == clone.Pixel(3, 3) {
+		t.Errorf("changing the clone changed the original canvas")
+	}
+	if clone.Bounds() != c.Bounds() || clone.Pixel(9, 9) != c.Pixel(9, 9) || clone.AtColorIndex(9, 9) != 18 {
+		t.Errorf("clone does not match the original canvas")
+	}
+
+	sub := c.SubCanvas(image.Rect(4, 5, 7, 20))
+	if want := image.Rect(4, 5, 7, 10); sub.Bounds() != want {
+		t.Fatalf("sub-canvas bounds are %v, want %v", sub.Bounds(), want)
+	}
+	if got, want := sub.Pixel(6, 9), c.Pixel(6, 9); got != want {
+		t.Errorf("sub-canvas Pixel(6, 9) = %v, want %v", got, want)
+	}
+	if got := sub.Pixel(7, 9); got != (RGB555{}) {
+		t.Errorf("sub-canvas Pixel(7, 9) outside its bounds = %v, want transparent", got)
+	}
+	sub.SetPixel(4, 5, RGB555{31, 31, 31, 255})
+	if got := c.Pixel(4, 5); got != (RGB555{31, 31, 31, 255}) {
+		t.Errorf("writing to the sub-canvas did not change the original canvas: %v", got)
+	}
+
+	dst := New(6, 6)
+	dst.CopyFrom(c)
+	if got, want := dst.Pixel(5, 5), c.Pixel(5, 5); got != want {
+		t.Errorf("copied Pixel(5, 5) = %v, want %v", got, want)
+	}
+	if got := dst.AtColorIndex(2, 3); got != 5 {
+		t.Errorf("copied AtColorIndex(2, 3) = %d, want 5", got)
+	}
+	if got := dst.Pixel(1, 1); got != (RGB555{}) {
+		t.Errorf("Pixel(1, 1) outside the copied area = %v, want transparent", got)
+	}
+
+	sub.CopyFrom(clone)
+	if got := c.Pixel(4, 5); got != clone.Pixel(4, 5) {
+		t.Errorf("copying into the sub-canvas: Pixel(4, 5) = %v, want %v", got, clone.Pixel(4, 5))
+	}
+	if got := c.Pixel(3, 3); got == clone.Pixel(3, 3) {
+		t.Errorf("copying into the sub-canvas changed a pixel outside of it")
+	}
+}
+
+func TestSetColorIndexRange(t *testing.T) {
+	c := New(2, 2)
+	if err := c.SetColorIndex(1, 1, MaxPaletteSize-1); err != nil {
+		t.Fatalf("SetColorIndex(%d) returned %s", MaxPaletteSize-1, err)
+	}
+	if got := c.AtColorIndex(1, 1); got != MaxPaletteSize-1 {
+		t.Errorf("AtColorIndex(1, 1) = %d, want %d", got, MaxPaletteSize-1)
+	}
+	for _, index := range []int{-1, MaxPaletteSize} {
+		if err := c.SetColorIndex(1, 1, index); err == nil {
+			t.Errorf("SetColorIndex(%d) did not return an error", index)
+		}
+	}
+	if got := c.AtColorIndex(1, 1); got != MaxPaletteSize-1 {
+		t.Errorf("AtColorIndex(1, 1) after invalid writes = %d, want %d", got, MaxPaletteSize-1)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	var empty Canvas
+	if err := empty.Validate(); err != ErrEmptyCanvas {
+		t.Errorf("empty canvas Validate() = %v, want ErrEmptyCanvas", err)
+	}
+
+	c := New(4, 4)
+	c.SetPixel(1, 2, RGB555{R: 31, G: 31, B: 31, A: 255})
+	if err := c.Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+	c.SetPixel(3, 2, RGB555{R: 1, G: 40, B: 1, A: 255})
+	err, ok := c.Validate().(*ChannelError)
+	if !ok {
+		t.Fatalf("Validate() = %v, want a *ChannelError", c.Validate())
+	}
+	if err.X != 3 || err.Y != 2 {
+		t.Errorf("ChannelError is at (%d, %d), want (3, 2)", err.X, err.Y)
+	}
+}
+
+func TestChannelMode(t *testing.T) {
+	bright := RGB555{R: 255, G: 16, B: 40, A: 255}
+
+	c := New(2, 2)
+	if err := c.SetPixel(0, 0, bright); err != nil || c.Pixel(0, 0) != bright {
+		t.Errorf("unchecked SetPixel stored %v with error %v, want %v", c.Pixel(0, 0), err, bright)
+	}
+
+	c.SetChannelMode(ChannelsClamp)
+	if err := c.SetPixel(1, 0, bright); err != nil || c.Pixel(1, 0) != (RGB555{31, 16, 31, 255}) {
+		t.Errorf("clamped SetPixel stored %v with error %v", c.Pixel(1, 0), err)
+	}
+
+	c.SetChannelMode(ChannelsStrict)
+	sub := c.SubCanvas(image.Rect(0, 1, 2, 2))
+	for _, target := range []*Canvas{&c, &sub} {
+		if err, ok := target.SetPixel(1, 1, bright).(*ChannelError); !ok || err.X != 1 || err.Y != 1 {
+			t.Errorf("strict SetPixel returned %v, want a *ChannelError at (1, 1)", err)
+		}
+		if got := target.Pixel(1, 1); got != (RGB555{}) {
+			t.Errorf("strict SetPixel changed the pixel to %v", got)
+		}
+	}
+
+	if got := c.Normalize(); got != 1 {
+		t.Errorf("Normalize() = %d, want 1", got)
+	}
+	if got := c.Pixel(0, 0); got != (RGB555{31, 16, 31, 255}) {
+		t.Errorf("normalized pixel is %v", got)
+	}
+	if got := c.Normalize(); got != 0 {
+		t.Errorf("second Normalize() = %d, want 0", got)
+	}
+}
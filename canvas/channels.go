@@ -0,0 +1,60 @@
+package canvas
+
+// ChannelMode selects how SetPixel handles colors whose channels are
+// outside of the 5-bit range 0-31.
+type ChannelMode int
+
+// The channel modes.
+const (
+	// ChannelsUnchecked stores colors as they are. It is the default, and
+	// matches the game, which never checks its colors.
+	ChannelsUnchecked ChannelMode = iota
+	// ChannelsClamp clamps each channel to 31 before storing the color.
+	ChannelsClamp
+	// ChannelsStrict rejects colors with out-of-range channels. SetPixel
+	// returns a *ChannelError, and leaves the pixel unchanged.
+	ChannelsStrict
+)
+
+// ChannelMode returns the canvas's channel mode.
+func (c *Canvas) ChannelMode() ChannelMode {
+	return c.channelMode
+}
+
+// SetChannelMode sets how SetPixel handles out-of-range channels. Canvases
+// created from this canvas value afterwards, such as clones and
+// sub-canvases, use the same mode.
+func (c *Canvas) SetChannelMode(mode ChannelMode) {
+	c.channelMode = mode
+}
+
+// Normalize clamps the channels of every pixel to the 5-bit range 0-31,
+// and returns the number of pixels that were out of range.
+func (c *Canvas) Normalize() int {
+	count := 0
+	for y := c.bounds.Min.Y; y < c.bounds.Max.Y; y++ {
+		for x := c.bounds.Min.X; x < c.bounds.Max.X; x++ {
+			i, _ := c.offset(x, y)
+			if !inRange(c.pixels[i]) {
+				c.pixels[i] = clamp(c.pixels[i])
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func inRange(color RGB555) bool {
+	return color.R <= 31 && color.G <= 31 && color.B <= 31
+}
+
+func clamp(color RGB555) RGB555 {
+	return RGB555{R: clampChannel(color.R), G: clampChannel(color.G), B: clampChannel(color.B), A: color.A}
+}
+
+func clampChannel(value uint8) uint8 {
+	if value > 31 {
+		return 31
+	}
+	return value
+}
@@ -0,0 +1,60 @@
+package canvas
+
+import "image/color"
+
+// RGB555 is a color with 5-bit channels, like the colors the GBA displays.
+// Each channel is in the range 0-31. A is 255 for opaque colors, and 0 for
+// transparent colors. Transparent colors may still hold an RGB value, which
+// some effects rely on.
+//
+// Unlike the game's packed 16-bit colors, which keep transparency in bit 15,
+// the channels are stored in separate bytes. A canvas in ChannelsUnchecked
+// mode keeps channels above 31, so that Validate can report them, and a
+// packed color has only 5 bits for each channel. Use BGR555 and FromBGR555
+// to convert to and from the packed format.
+//
+// RGB555 implements color.Color. Its RGBA method expands the channels the
+// same way as ExpandShift.
+type RGB555 struct {
+	R, G, B, A uint8
+}
+
+// RGBA implements color.Color.
+func (c RGB555) RGBA() (r, g, b, a uint32) {
+	return ExpandShift.Expand(c).RGBA()
+}
+
+// Opaque reports whether the color is opaque.
+func (c RGB555) Opaque() bool {
+	return c.A == 255
+}
+
+// BGR555 packs the color into the GBA's 15-bit color format: red in the
+// low bits, then green, then blue. Transparency is not stored, and bit 15
+// is always clear.
+func (c RGB555) BGR555() uint16 {
+	return uint16(c.R&0x1F) | uint16(c.G&0x1F)<<5 | uint16(c.B&0x1F)<<10
+}
+
+// FromBGR555 unpacks an opaque color from the GBA's 15-bit color format.
+// Bit 15 is ignored.
+func FromBGR555(value uint16) RGB555 {
+	return RGB555{
+		R: uint8(value & 0x1F),
+		G: uint8(value >> 5 & 0x1F),
+		B: uint8(value >> 10 & 0x1F),
+		A: 255,
+	}
+}
+
+// RGB555Model converts colors into RGB555 colors, the same way FromImage
+// does: channels are reduced with ConvertFloor, and only fully opaque
+// colors stay opaque.
+var RGB555Model color.Model = color.ModelFunc(rgb555Model)
+
+func rgb555Model(c color.Color) color.Color {
+	if c, ok := c.(RGB555); ok {
+		return c
+	}
+	return FromImageOptions{}.ConvertColor(c)
+}
@@ -0,0 +1,41 @@
+package canvas
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrEmptyCanvas is returned by Validate for canvases without any pixels.
+var ErrEmptyCanvas = errors.New("canvas is empty")
+
+// ChannelError is returned by Validate, and by SetPixel in ChannelsStrict
+// mode, for a pixel whose color has a channel outside of the 5-bit range
+// 0-31.
+type ChannelError struct {
+	X, Y  int
+	Color RGB555
+}
+
+func (e *ChannelError) Error() string {
+	return fmt.Sprintf("pixel (%d, %d) has color %v, but channels must be in the range 0-31", e.X, e.Y, e.Color)
+}
+
+// Validate checks that the canvas can be processed. It returns
+// ErrEmptyCanvas if the canvas has no pixels, or a *ChannelError for the
+// first pixel whose color channels are out of range. Effects do not check
+// their input, so out-of-range channels produce garbage colors, or color
+// indexes outside of the quantized palette.
+func (c *Canvas) Validate() error {
+	if c.bounds.Empty() {
+		return ErrEmptyCanvas
+	}
+	for y := c.bounds.Min.Y; y < c.bounds.Max.Y; y++ {
+		for x := c.bounds.Min.X; x < c.bounds.Max.X; x++ {
+			pixel := c.Pixel(x, y)
+			if !inRange(pixel) {
+				return &ChannelError{X: x, Y: y, Color: pixel}
+			}
+		}
+	}
+	return nil
+}
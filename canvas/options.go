@@ -0,0 +1,229 @@
+package canvas
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// ColorConversion selects how FromImage converts color channels into the
+// canvas's 5-bit color space.
+type ColorConversion int
+
+// The color conversion modes.
+const (
+	// ConvertFloor drops the low bits of each channel, like the game's
+	// graphics build tools do. An 8-bit value v becomes v/8.
+	ConvertFloor ColorConversion = iota
+	// ConvertRound rounds each channel to the nearest 5-bit value, scaling
+	// the full channel range to 0-31.
+	ConvertRound
+	// ConvertGBA picks the 5-bit value whose GBA-style expansion
+	// (v<<3 | v>>2) is closest to the full 16-bit channel. It is the inverse
+	// of how emulators display GBA colors, so emulator screenshots convert
+	// back to the original colors.
+	ConvertGBA
+	// ConvertPassthrough uses each 8-bit channel value as-is. It is meant
+	// for images whose channels already hold 5-bit values, such as graphics
+	// extracted from ROMs.
+	ConvertPassthrough
+)
+
+// AlphaMode selects how FromImage decides which pixels are transparent.
+type AlphaMode int
+
+// The alpha modes.
+const (
+	// AlphaOpaqueOnly keeps only fully opaque pixels. Any other alpha value
+	// becomes transparent.
+	AlphaOpaqueOnly AlphaMode = iota
+	// AlphaThreshold keeps pixels whose 8-bit alpha is at least
+	// FromImageOptions.AlphaThreshold, and makes the rest transparent. Kept
+	// pixels use their un-premultiplied color.
+	AlphaThreshold
+	// AlphaMatte composites every pixel over FromImageOptions.Matte, so the
+	// whole canvas is opaque. Anti-aliased edges and shadows blend into the
+	// matte color, instead of disappearing.
+	AlphaMatte
+	// AlphaColorKey makes pixels matching FromImageOptions.ColorKey
+	// transparent, like the GBA does with palette index 0. Fully transparent
+	// pixels stay transparent, and every other pixel is opaque.
+	AlphaColorKey
+)
+
+// FromImageOptions configures how FromImageWithOptions builds a canvas.
+type FromImageOptions struct {
+	ColorConversion ColorConversion
+	AlphaMode       AlphaMode
+	// AlphaThreshold is the smallest 8-bit alpha value kept by
+	// AlphaThreshold.
+	AlphaThreshold uint8
+	// Matte is the background color used by AlphaMatte. Defaults to black.
+	Matte color.Color
+	// ColorKey is the color made transparent by AlphaColorKey. Only its
+	// RGB channels are compared, with 8 bits of precision.
+	ColorKey color.Color
+}
+
+// FromImageWithOptions builds a new Canvas from a given image, using the
+// given options. The canvas has the same bounds as the image.
+func FromImageWithOptions(imageData image.Image, opts FromImageOptions) Canvas {
+	bounds := imageData.Bounds()
+	c := NewRect(bounds)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c.SetPixel(x, y, opts.ConvertColor(imageData.At(x, y)))
+		}
+	}
+	return c
+}
+
+// ConvertColor converts a single color into an RGB555 color, the same way
+// FromImageWithOptions converts each pixel.
+func (opts FromImageOptions) ConvertColor(col color.Color) RGB555 {
+	r, g, b, opaque := opts.resolveAlpha(col)
+	convertedRed := opts.ColorConversion.convert(r)
+	convertedGreen := opts.ColorConversion.convert(g)
+	convertedBlue := opts.ColorConversion.convert(b)
+	var convertedAlpha uint8
+	if opaque {
+		convertedAlpha = 255
+	}
+	return RGB555{convertedRed, convertedGreen, convertedBlue, convertedAlpha}
+}
+
+// resolveAlpha applies the alpha mode to a pixel. It returns the 16-bit
+// color channels to convert, and whether the pixel is opaque.
+func (opts FromImageOptions) resolveAlpha(pixel color.Color) (r, g, b uint32, opaque bool) {
+	r, g, b, a := pixel.RGBA()
+	switch opts.AlphaMode {
+	case AlphaThreshold:
+		if a == 0 || a>>8 < uint32(opts.AlphaThreshold) {
+			return r, g, b, false
+		}
+		return r * 0xFFFF / a, g * 0xFFFF / a, b * 0xFFFF / a, true
+	case AlphaMatte:
+		matte := opts.Matte
+		if matte == nil {
+			matte = color.Black
+		}
+		mr, mg, mb, _ := matte.RGBA()
+		return r + mr*(0xFFFF-a)/0xFFFF, g + mg*(0xFFFF-a)/0xFFFF, b + mb*(0xFFFF-a)/0xFFFF, true
+	case AlphaColorKey:
+		if a == 0 {
+			return r, g, b, false
+		}
+		r, g, b = r*0xFFFF/a, g*0xFFFF/a, b*0xFFFF/a
+		if opts.ColorKey != nil {
+			kr, kg, kb, _ := opts.ColorKey.RGBA()
+			if r>>8 == kr>>8 && g>>8 == kg>>8 && b>>8 == kb>>8 {
+				return r, g, b, false
+			}
+		}
+		return r, g, b, true
+	}
+	return r, g, b, a == 0xFFFF
+}
+
+// convert converts a 16-bit color channel into a 5-bit channel.
+func (conversion ColorConversion) convert(value uint32) uint8 {
+	switch conversion {
+	case ConvertRound:
+		return uint8((value*31 + 0xFFFF/2) / 0xFFFF)
+	case ConvertGBA:
+		return nearestGBAChannel(value)
+	case ConvertPassthrough:
+		return uint8(value >> 8)
+	}
+	return uint8(value >> 11)
+}
+
+// expandGBAChannel expands a 5-bit channel into a 16-bit channel, the way
+// emulators expand GBA colors into 8-bit channels.
+func expandGBAChannel(value uint8) uint32 {
+	expanded := uint32(value<<3 | value>>2)
+	return expanded * 0x101
+}
+
+func nearestGBAChannel(value uint32) uint8 {
+	best := uint8(value >> 11)
+	bestDistance := distance(expandGBAChannel(best), value)
+	for _, candidate := range []uint8{best - 1, best + 1} {
+		if candidate > 31 {
+			continue
+		}
+		if d := distance(expandGBAChannel(candidate), value); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+func distance(a, b uint32) uint32 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+// ColorExpansion selects how ToImage expands the canvas's 5-bit colors into
+// 8-bit colors.
+type ColorExpansion int
+
+// The color expansion modes.
+const (
+	// ExpandShift multiplies each channel by 8, so white becomes 248.
+	ExpandShift ColorExpansion = iota
+	// ExpandReplicate copies the top bits of each channel into the low
+	// bits (v<<3 | v>>2), so the full 0-255 range is used.
+	ExpandReplicate
+	// ExpandGBALCD approximates how colors look on the GBA's LCD screen,
+	// which is darker and less saturated than a PC monitor. It uses the
+	// same color correction curves as many GBA emulators.
+	ExpandGBALCD
+)
+
+// ToImageOptions configures how ToImageWithOptions and
+// ToPalettedWithOptions build images.
+type ToImageOptions struct {
+	ColorExpansion ColorExpansion
+}
+
+// Expand converts a 5-bit color into an 8-bit color. Transparent colors
+// become fully transparent black.
+func (expansion ColorExpansion) Expand(c RGB555) color.RGBA {
+	if c.A != 255 {
+		return color.RGBA{}
+	}
+	switch expansion {
+	case ExpandReplicate:
+		return color.RGBA{replicate(c.R), replicate(c.G), replicate(c.B), 255}
+	case ExpandGBALCD:
+		return gbaLCDColor(c)
+	}
+	return color.RGBA{c.R * 8, c.G * 8, c.B * 8, 255}
+}
+
+func replicate(value uint8) uint8 {
+	return value<<3 | value>>2
+}
+
+// gbaLCDColor applies a GBA LCD color correction. The screen's gamma is
+// roughly 4.0, and its colors bleed into each other, so each output channel
+// mixes all three input channels before being re-encoded with a 2.2 gamma.
+func gbaLCDColor(c RGB555) color.RGBA {
+	const lcdGamma = 4.0
+	const outGamma = 2.2
+	r := math.Pow(float64(c.R)/31, lcdGamma)
+	g := math.Pow(float64(c.G)/31, lcdGamma)
+	b := math.Pow(float64(c.B)/31, lcdGamma)
+	channel := func(value float64) uint8 {
+		return uint8(math.Pow(value/255, 1/outGamma)*255*255/280 + 0.5)
+	}
+	return color.RGBA{
+		R: channel(0*b + 50*g + 255*r),
+		G: channel(30*b + 230*g + 10*r),
+		B: channel(220*b + 10*g + 50*r),
+		A: 255,
+	}
+}
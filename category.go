@@ -0,0 +1,138 @@
+package contestpaintingeffects
+
+import (
+	"fmt"
+	"image"
+	"strings"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+	"github.com/huderlem/contest-painting-effects/trace"
+)
+
+// Category is a Pokémon Contest category. The painting effect used for a
+// contest winner's portrait is chosen by the category of the contest.
+type Category int
+
+// The contest categories, in the same order as the game's CONTEST_CATEGORY_* values.
+const (
+	Cool Category = iota
+	Beauty
+	Cute
+	Smart
+	Tough
+)
+
+var categoryNames = []string{
+	Cool:   "cool",
+	Beauty: "beauty",
+	Cute:   "cute",
+	Smart:  "smart",
+	Tough:  "tough",
+}
+
+// Categories returns all of the contest categories, in game order.
+func Categories() []Category {
+	return []Category{Cool, Beauty, Cute, Smart, Tough}
+}
+
+// String returns the lowercase name of the category.
+func (cat Category) String() string {
+	if cat < 0 || int(cat) >= len(categoryNames) {
+		return fmt.Sprintf("Category(%d)", int(cat))
+	}
+	return categoryNames[cat]
+}
+
+// ParseCategory returns the category with the given name. Names are
+// case-insensitive, and surrounding whitespace is ignored.
+func ParseCategory(name string) (Category, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for i, categoryName := range categoryNames {
+		if name == categoryName {
+			return Category(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown contest category %q", name)
+}
+
+// MarshalText implements encoding.TextMarshaler, so categories can be used
+// in config files.
+func (cat Category) MarshalText() ([]byte, error) {
+	if cat < 0 || int(cat) >= len(categoryNames) {
+		return nil, fmt.Errorf("invalid contest category %d", int(cat))
+	}
+	return []byte(cat.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (cat *Category) UnmarshalText(text []byte) error {
+	parsed, err := ParseCategory(string(text))
+	if err != nil {
+		return err
+	}
+	*cat = parsed
+	return nil
+}
+
+// Options holds the inputs to Apply that only some categories use.
+type Options struct {
+	// Personality is the lower 8 bits of the winning mon's personality
+	// value. It determines the color that the dark pixels of Cool paintings
+	// are drawn with.
+	Personality uint8
+	// Region limits the painting to an area of the canvas. The zero value
+	// paints the whole canvas.
+	Region image.Rectangle
+	// Trace, if set, records the canvas after each step of the painting.
+	Trace *trace.Trace
+}
+
+// ImageEffect returns the image effect the game uses for the category's
+// contest winner paintings.
+func (cat Category) ImageEffect() ImageEffect {
+	switch cat {
+	case Cool:
+		return ImageEffectOutlineColored
+	case Beauty:
+		return ImageEffectShimmer
+	case Cute:
+		return ImageEffectPointillism
+	case Smart:
+		return ImageEffectCharcoal
+	case Tough:
+		return ImageEffectGrayscaleLight
+	}
+	return ImageEffectNone
+}
+
+// QuantizeEffect returns the quantize effect the game uses for the category's
+// contest winner paintings.
+func (cat Category) QuantizeEffect() QuantizeEffect {
+	switch cat {
+	case Smart, Tough:
+		return QuantizeEffectGrayscale
+	}
+	return QuantizeEffectStandardLimitedColors
+}
+
+// Apply applies the painting effect for the given contest category, the same
+// way the game picks the effect for a contest winner's painting. It returns
+// the quantized palette. Invalid canvases and regions are reported with the
+// same errors as ImageProcessingContext.Validate.
+func Apply(c canvas.Canvas, cat Category, opts Options) ([]canvas.RGB555, error) {
+	if cat < 0 || int(cat) >= len(categoryNames) {
+		return nil, fmt.Errorf("invalid contest category %d", int(cat))
+	}
+	context := ImageProcessingContext{
+		Canvas:         c,
+		Effect:         cat.ImageEffect(),
+		QuantizeEffect: cat.QuantizeEffect(),
+		Personality:    opts.Personality,
+		Region:         opts.Region,
+		Trace:          opts.Trace,
+	}
+	if err := ApplyImageProcessingEffects(&context); err != nil {
+		return nil, err
+	}
+	return ApplyImageProcessingQuantization(&context)
+}
@@ -0,0 +1,390 @@
+// Command contestpaint applies a Pokémon Contest painting effect to images.
+//
+// Usage:
+//
+//	contestpaint [flags] input...
+//
+// With a single input, -o names the output file. With multiple inputs, -o
+// names a directory, and each output is named after its input, so inputs
+// must have different file names.
+//
+// The batch subcommand applies every contest category to each image in a
+// directory, and writes the paintings and a manifest to an output directory:
+//
+//	contestpaint batch [-workers n] [-format png|indexed] -o outdir srcdir
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	contestpaintingeffects "github.com/huderlem/contest-painting-effects"
+	"github.com/huderlem/contest-painting-effects/batch"
+	"github.com/huderlem/contest-painting-effects/canvas"
+	"github.com/huderlem/contest-painting-effects/gba"
+	"github.com/huderlem/contest-painting-effects/trace"
+)
+
+// Exit codes.
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// Output formats.
+const (
+	formatPNG        = "png"
+	formatIndexedPNG = "indexed"
+	formatGBA        = "gba"
+	formatC          = "c"
+)
+
+type config struct {
+	category    contestpaintingeffects.Category
+	personality uint8
+	format      string
+	output      string
+	compress    bool
+	symbol      string
+	trace       bool
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	if len(args) > 0 && args[0] == "batch" {
+		return runBatch(args[1:])
+	}
+	flags := flag.NewFlagSet("contestpaint", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: contestpaint [flags] input...\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+	categoryName := flags.String("category", "cool", "contest category: cool, beauty, cute, smart or tough")
+	personality := flags.Uint("personality", 0, "lower 8 bits of the mon's personality value (used by cool)")
+	format := flags.String("format", formatPNG, "output format: png, indexed (indexed png), gba (4bpp/8bpp tiles, .gbapal palette and tilemap) or c (C source and header with the gba data)")
+	output := flags.String("o", "", "output file, or output directory when there are multiple inputs")
+	compress := flags.Bool("lz", false, "LZ77-compress the gba tiles and palette, like the game's .lz assets")
+	symbol := flags.String("symbol", "", "C array name prefix for the c format (default: derived from the output file name)")
+	traceSteps := flags.Bool("trace", false, "also write an animated gif and a contact sheet png showing each step of the painting")
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	category, err := contestpaintingeffects.ParseCategory(*categoryName)
+	if err != nil {
+		return usageError(flags, err.Error())
+	}
+	if *personality > 255 {
+		return usageError(flags, fmt.Sprintf("personality %d is out of range 0-255", *personality))
+	}
+	switch *format {
+	case formatPNG, formatIndexedPNG, formatGBA, formatC:
+	default:
+		return usageError(flags, fmt.Sprintf("unknown output format %q", *format))
+	}
+	if *compress && *format != formatGBA {
+		return usageError(flags, fmt.Sprintf("-lz is only supported with the gba format, not %q", *format))
+	}
+	if *symbol != "" && *format != formatC {
+		return usageError(flags, fmt.Sprintf("-symbol is only supported with the c format, not %q", *format))
+	}
+	inputs := flags.Args()
+	if len(inputs) == 0 {
+		return usageError(flags, "no input images given")
+	}
+	if *output == "" {
+		return usageError(flags, "no output path given")
+	}
+	outputs, err := outputPaths(inputs, *output)
+	if err != nil {
+		return usageError(flags, err.Error())
+	}
+
+	cfg := config{
+		category:    category,
+		personality: uint8(*personality),
+		format:      *format,
+		output:      *output,
+		compress:    *compress,
+		symbol:      *symbol,
+		trace:       *traceSteps,
+	}
+
+	if len(inputs) > 1 {
+		if err := os.MkdirAll(cfg.output, 0755); err != nil {
+			fmt.Fprintf(os.Stderr, "contestpaint: %s\n", err)
+			return exitError
+		}
+	}
+	status := exitOK
+	for i, input := range inputs {
+		if err := processFile(input, outputs[i], cfg); err != nil {
+			fmt.Fprintf(os.Stderr, "contestpaint: %s\n", err)
+			status = exitError
+		}
+	}
+	return status
+}
+
+// outputPaths returns the output path of each input. A single input is
+// written to the output path. Multiple inputs are written to the output
+// directory, named after their inputs, so inputs with the same name in
+// different directories would overwrite each other, and are rejected.
+func outputPaths(inputs []string, output string) ([]string, error) {
+	if len(inputs) == 1 {
+		return []string{output}, nil
+	}
+	paths := make([]string, len(inputs))
+	seen := make(map[string]string)
+	for i, input := range inputs {
+		name := strings.TrimSuffix(filepath.Base(input), filepath.Ext(input))
+		if other, ok := seen[name]; ok {
+			return nil, fmt.Errorf("inputs %s and %s would both be written to %s", other, input, filepath.Join(output, name))
+		}
+		seen[name] = input
+		paths[i] = filepath.Join(output, name)
+	}
+	return paths, nil
+}
+
+func runBatch(args []string) int {
+	flags := flag.NewFlagSet("contestpaint batch", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: contestpaint batch [flags] srcdir\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+	workers := flags.Int("workers", 0, "maximum number of images processed at once (default: number of CPUs)")
+	format := flags.String("format", formatPNG, "output format: png or indexed (indexed png)")
+	output := flags.String("o", "", "output directory")
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if *format != formatPNG && *format != formatIndexedPNG {
+		return usageError(flags, fmt.Sprintf("unsupported batch output format %q", *format))
+	}
+	if flags.NArg() != 1 {
+		return usageError(flags, "expected exactly one source directory")
+	}
+	if *output == "" {
+		return usageError(flags, "no output directory given")
+	}
+
+	manifest, err := batch.ProcessDir(flags.Arg(0), *output, batch.Options{
+		Workers: *workers,
+		Indexed: *format == formatIndexedPNG,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "contestpaint: %s\n", err)
+		return exitError
+	}
+	for _, entry := range manifest.Entries {
+		if entry.Error != "" {
+			fmt.Fprintf(os.Stderr, "contestpaint: %s\n", entry.Error)
+		}
+	}
+	if manifest.Failed() > 0 {
+		return exitError
+	}
+	return exitOK
+}
+
+func usageError(flags *flag.FlagSet, message string) int {
+	fmt.Fprintf(flags.Output(), "contestpaint: %s\n", message)
+	flags.Usage()
+	return exitUsage
+}
+
+func processFile(inputPath, outputPath string, cfg config) error {
+	img, err := loadImage(inputPath)
+	if err != nil {
+		return err
+	}
+	c := canvas.FromImage(img)
+	options := contestpaintingeffects.Options{Personality: cfg.personality}
+	if cfg.trace {
+		options.Trace = &trace.Trace{}
+	}
+	palette, err := contestpaintingeffects.Apply(c, cfg.category, options)
+	if err != nil {
+		return fmt.Errorf("%s: %s", inputPath, err)
+	}
+	if cfg.trace {
+		if err := saveTrace(outputPath, options.Trace); err != nil {
+			return fmt.Errorf("%s: %s", inputPath, err)
+		}
+	}
+	switch cfg.format {
+	case formatPNG:
+		err = savePNG(withExt(outputPath, ".png"), c.ToImage(palette))
+	case formatIndexedPNG:
+		err = savePNG(withExt(outputPath, ".png"), c.ToPaletted(palette))
+	case formatGBA:
+		err = saveGBA(outputPath, c, palette, cfg.compress)
+	case formatC:
+		err = saveCSource(outputPath, c, palette, cfg.symbol)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %s", inputPath, err)
+	}
+	return nil
+}
+
+func loadImage(path string) (image.Image, error) {
+	imageFile, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening input image file: %s", err)
+	}
+	defer imageFile.Close()
+
+	img, _, err := image.Decode(imageFile)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding image file %s: %s", path, err)
+	}
+	return img, nil
+}
+
+func savePNG(path string, img image.Image) error {
+	outputFile, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error saving image: %s", err)
+	}
+	if err := png.Encode(outputFile, img); err != nil {
+		outputFile.Close()
+		return fmt.Errorf("error encoding image %s: %s", path, err)
+	}
+	return outputFile.Close()
+}
+
+// saveGBA writes the tiles, palette and tilemap next to each other, using the
+// output path as the base name. Palettes small enough for a single 4bpp palette
+// bank produce 4bpp tiles. Otherwise, 8bpp tiles are written. When compress is
+// set, the tiles and palette are LZ77-compressed, and get a .lz extension.
+func saveGBA(basePath string, c canvas.Canvas, palette []canvas.RGB555, compress bool) error {
+	base := strings.TrimSuffix(basePath, filepath.Ext(basePath))
+	depth := gba.DepthForPalette(palette)
+	extension := fmt.Sprintf(".%dbpp", int(depth))
+	tiles, err := gba.Tiles(c, depth)
+	if err != nil {
+		return err
+	}
+	tilemap, err := gba.Tilemap(c, 0)
+	if err != nil {
+		return err
+	}
+	files := []struct {
+		path string
+		data []byte
+	}{
+		{base + extension, tiles},
+		{base + ".gbapal", gba.Palette(palette)},
+		{base + ".bin", tilemap},
+	}
+	if compress {
+		for i := range files[:2] {
+			compressed, err := gba.CompressLZ77VRAMSafe(files[i].data)
+			if err != nil {
+				return err
+			}
+			files[i].path += ".lz"
+			files[i].data = compressed
+		}
+	}
+	for _, file := range files {
+		if err := ioutil.WriteFile(file.path, file.data, 0644); err != nil {
+			return fmt.Errorf("error saving %s: %s", file.path, err)
+		}
+	}
+	return nil
+}
+
+// saveTrace writes the painting steps next to the output, as base-trace.gif and
+// base-trace.png.
+func saveTrace(basePath string, t *trace.Trace) error {
+	base := strings.TrimSuffix(basePath, filepath.Ext(basePath)) + "-trace"
+	files := []struct {
+		path   string
+		encode func(io.Writer, trace.RenderOptions) error
+	}{
+		{base + ".gif", t.EncodeGIF},
+		{base + ".png", t.EncodeContactSheet},
+	}
+	for _, file := range files {
+		var buf bytes.Buffer
+		if err := file.encode(&buf, trace.RenderOptions{}); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(file.path, buf.Bytes(), 0644); err != nil {
+			return fmt.Errorf("error saving %s: %s", file.path, err)
+		}
+	}
+	return nil
+}
+
+// saveCSource writes a C source file and its header, using the output path as
+// the base name. Without a symbol, the arrays are named after the output file,
+// so painting.c declares gPainting_Gfx.
+func saveCSource(basePath string, c canvas.Canvas, palette []canvas.RGB555, symbol string) error {
+	base := strings.TrimSuffix(basePath, filepath.Ext(basePath))
+	if symbol == "" {
+		symbol = symbolName(filepath.Base(base))
+	}
+	header := base + ".h"
+	opts := gba.CSourceOptions{Symbol: symbol, Header: filepath.Base(header)}
+	files := []struct {
+		path  string
+		write func(io.Writer) error
+	}{
+		{base + ".c", func(w io.Writer) error { return gba.WriteCSource(w, c, palette, opts) }},
+		{header, func(w io.Writer) error { return gba.WriteCHeader(w, opts) }},
+	}
+	for _, file := range files {
+		var buf bytes.Buffer
+		if err := file.write(&buf); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(file.path, buf.Bytes(), 0644); err != nil {
+			return fmt.Errorf("error saving %s: %s", file.path, err)
+		}
+	}
+	return nil
+}
+
+// symbolName turns a file name like contest_painting-1 into a C symbol name
+// like gContestPainting1.
+func symbolName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+	symbol := "g"
+	for _, part := range parts {
+		symbol += strings.ToUpper(part[:1]) + part[1:]
+	}
+	return symbol
+}
+
+func withExt(path, extension string) string {
+	if filepath.Ext(path) != "" {
+		return path
+	}
+	return path + extension
+}
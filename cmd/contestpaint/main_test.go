@@ -0,0 +1,107 @@
+package main
+
+import (
+	"image"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	contestpaintingeffects "github.com/huderlem/contest-painting-effects"
+)
+
+const testSprite = "../../testdata/sprites/charizard.png"
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "contestpaint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestRunUsageErrors(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	out := filepath.Join(dir, "out")
+	for _, args := range [][]string{
+		{"-category", "clever", "-o", out, testSprite},
+		{"-personality", "256", "-o", out, testSprite},
+		{"-format", "bmp", "-o", out, testSprite},
+		{"-format", "c", "-lz", "-o", out, testSprite},
+		{"-lz", "-o", out, testSprite},
+		{"-format", "gba", "-symbol", "gPainting", "-o", out, testSprite},
+		{"-symbol", "gPainting", "-o", out, testSprite},
+		{"-o", out},
+		{testSprite},
+		{"-o", out, testSprite, "../../testdata/sprites/../sprites/charizard.png"},
+	} {
+		if status := run(args); status != exitUsage {
+			t.Errorf("run(%q) = %d, want %d", args, status, exitUsage)
+		}
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 0 {
+		t.Errorf("usage errors wrote %d files", len(files))
+	}
+}
+
+func TestRunWritesOutputs(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	single := filepath.Join(dir, "painting.png")
+	if status := run([]string{"-category", "smart", "-o", single, testSprite}); status != exitOK {
+		t.Fatalf("single input: got status %d", status)
+	}
+	f, err := os.Open(single)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(f)
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Bounds() != image.Rect(0, 0, 64, 64) {
+		t.Errorf("painting bounds are %v, want 64x64", img.Bounds())
+	}
+
+	outDir := filepath.Join(dir, "gba")
+	args := []string{"-format", "gba", "-lz", "-o", outDir, testSprite, "../../testdata/sprites/arcanine.png"}
+	if status := run(args); status != exitOK {
+		t.Fatalf("multiple inputs: got status %d", status)
+	}
+	for _, name := range []string{"charizard.8bpp.lz", "charizard.gbapal.lz", "charizard.bin", "arcanine.bin"} {
+		if _, err := os.Stat(filepath.Join(outDir, name)); err != nil {
+			t.Errorf("missing output: %s", err)
+		}
+	}
+}
+
+func TestProcessFileErrorsNameInput(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	// A 12x12 image is not a whole number of tiles, so it cannot be
+	// written in the gba format.
+	input := filepath.Join(dir, "small.png")
+	f, err := os.Create(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := png.Encode(f, image.NewRGBA(image.Rect(0, 0, 12, 12))); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	cfg := config{category: contestpaintingeffects.Smart, format: formatGBA}
+	err = processFile(input, filepath.Join(dir, "out"), cfg)
+	if err == nil || !strings.Contains(err.Error(), input) || !strings.Contains(err.Error(), "tile size") {
+		t.Errorf("got error %v, want a tile size error naming %s", err, input)
+	}
+	if status := run([]string{"-format", "gba", "-o", filepath.Join(dir, "out"), input}); status != exitError {
+		t.Errorf("run with an invalid gba input: got status %d, want %d", status, exitError)
+	}
+}
@@ -1,48 +1,64 @@
 package contestpaintingeffects
 
 import (
-	"image/color"
+	"image"
 
 	"github.com/huderlem/contest-painting-effects/canvas"
 	"github.com/huderlem/contest-painting-effects/effect"
 	"github.com/huderlem/contest-painting-effects/paletteq"
+	"github.com/huderlem/contest-painting-effects/pixelq"
 )
 
+// Each of the contest effects accepts an optional region, which limits the
+// effects and quantization to that area of the canvas.
+
 // ApplyCoolEffect applies the effects used for Cool contest winner paintings.
-func ApplyCoolEffect(c canvas.Canvas, personality uint8) []color.RGBA {
-	effect.ApplyBlackOutline(c)
-	effect.ApplyPersonalityColor(c, personality)
-	return paletteq.ApplyStandardQuantization(c, 224)
+func ApplyCoolEffect(c canvas.Canvas, personality uint8, region ...image.Rectangle) []canvas.RGB555 {
+	effect.ApplyBlackOutline(c, region...)
+	effect.ApplyPersonalityColor(c, personality, region...)
+	return paletteq.ApplyStandardQuantization(c, 224, region...)
 }
 
 // ApplyBeautyEffect applies the effects used for Beauty contest winner paintings.
-func ApplyBeautyEffect(c canvas.Canvas) []color.RGBA {
-	effect.ApplyShimmer(c)
-	return paletteq.ApplyStandardQuantization(c, 224)
+func ApplyBeautyEffect(c canvas.Canvas, region ...image.Rectangle) []canvas.RGB555 {
+	effect.ApplyShimmer(c, region...)
+	return paletteq.ApplyStandardQuantization(c, 224, region...)
 }
 
 // ApplyCuteEffect applies the effects used for Cute contest winner paintings.
-func ApplyCuteEffect(c canvas.Canvas) []color.RGBA {
-	effect.ApplyPointillism(c)
-	return paletteq.ApplyStandardQuantization(c, 224)
+func ApplyCuteEffect(c canvas.Canvas, region ...image.Rectangle) []canvas.RGB555 {
+	effect.ApplyPointillism(c, region...)
+	return paletteq.ApplyStandardQuantization(c, 224, region...)
+}
+
+// ApplySeededCuteEffect is like ApplyCuteEffect, but replaces the game's dot
+// pattern with one generated from the seed. Different seeds give different
+// paintings, and the same seed always gives the same painting.
+func ApplySeededCuteEffect(c canvas.Canvas, seed int64, region ...image.Rectangle) []canvas.RGB555 {
+	table := pixelq.GeneratePointillismTable(seed, pixelq.NumPointillismPoints)
+	if err := effect.ApplyPointillismWithTable(c, table, region...); err != nil {
+		// Generated tables are always valid.
+		panic(err)
+	}
+	return paletteq.ApplyStandardQuantization(c, 224, region...)
 }
 
 // ApplySmartEffect applies the effects used for Smart contest winner paintings.
-func ApplySmartEffect(c canvas.Canvas) []color.RGBA {
-	effect.ApplyBlackOutline(c)
-	effect.ApplyBlurRight(c)
-	effect.ApplyBlurDown(c)
-	effect.ApplyBlackAndWhite(c)
-	effect.ApplyBlur(c)
-	effect.ApplyBlur(c)
-	effect.ApplyRedChannelGrayscale(c, 2)
-	effect.ApplyRedChannelGrayscaleHighlight(c, 4)
-	return paletteq.ApplyGrayscaleQuantization(c)
+func ApplySmartEffect(c canvas.Canvas, region ...image.Rectangle) []canvas.RGB555 {
+	effect.ApplyBlackOutline(c, region...)
+	effect.ApplyBlurRight(c, region...)
+	effect.ApplyBlurDown(c, region...)
+	effect.ApplyBlackAndWhite(c, region...)
+	effect.ApplyBlur(c, region...)
+	effect.ApplyBlur(c, region...)
+	effect.ApplyRedChannelGrayscale(c, 2, region...)
+	effect.ApplyRedChannelGrayscaleHighlight(c, 4, region...)
+	return paletteq.ApplyGrayscaleQuantization(c, region...)
 }
 
 // ApplyToughEffect applies the effects used for Tough contest winner paintings.
-func ApplyToughEffect(c canvas.Canvas) []color.RGBA {
-	effect.ApplyGrayscale(c)
-	effect.ApplyRedChannelGrayscale(c, 3)
-	return paletteq.ApplyGrayscaleQuantization(c)
+func ApplyToughEffect(c canvas.Canvas, region ...image.Rectangle) []canvas.RGB555 {
+	effect.ApplyGrayscale(c, region...)
+	effect.ApplyRedChannelGrayscale(c, 3, region...)
+	return paletteq.ApplyGrayscaleQuantization(c, region...)
 }
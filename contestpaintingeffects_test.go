@@ -0,0 +1,378 @@
+package contestpaintingeffects
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"image"
+	_ "image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+	"github.com/huderlem/contest-painting-effects/effect"
+	"github.com/huderlem/contest-painting-effects/paletteq"
+	"github.com/huderlem/contest-painting-effects/trace"
+)
+
+var update = flag.Bool("update", false, "regenerate the golden files in testdata/golden")
+
+var goldenSprites = []string{"arcanine", "charizard", "gradient"}
+
+var goldenCoolPersonalities = []uint8{0, 7, 14, 41, 255}
+
+type goldenCase struct {
+	name  string
+	apply func(c canvas.Canvas) []canvas.RGB555
+}
+
+func goldenCases() []goldenCase {
+	var cases []goldenCase
+	for _, personality := range goldenCoolPersonalities {
+		personality := personality
+		cases = append(cases, goldenCase{
+			name: fmt.Sprintf("cool-%03d", personality),
+			apply: func(c canvas.Canvas) []canvas.RGB555 {
+				return ApplyCoolEffect(c, personality)
+			},
+		})
+	}
+	return append(cases,
+		goldenCase{"beauty", func(c canvas.Canvas) []canvas.RGB555 { return ApplyBeautyEffect(c) }},
+		goldenCase{"cute", func(c canvas.Canvas) []canvas.RGB555 { return ApplyCuteEffect(c) }},
+		goldenCase{"smart", func(c canvas.Canvas) []canvas.RGB555 { return ApplySmartEffect(c) }},
+		goldenCase{"tough", func(c canvas.Canvas) []canvas.RGB555 { return ApplyToughEffect(c) }},
+	)
+}
+
+func TestGolden(t *testing.T) {
+	for _, sprite := range goldenSprites {
+		img := loadSprite(t, sprite)
+		for _, tc := range goldenCases() {
+			name := sprite + "_" + tc.name
+			t.Run(name, func(t *testing.T) {
+				c := canvas.FromImage(img)
+				palette := tc.apply(c)
+				checkGolden(t, name, c, palette)
+			})
+		}
+	}
+}
+
+// TestApplyMatchesGolden checks that the category dispatcher produces the
+// same paintings as the individual contest effects.
+func TestApplyMatchesGolden(t *testing.T) {
+	for _, sprite := range goldenSprites {
+		img := loadSprite(t, sprite)
+		for _, cat := range Categories() {
+			name := sprite + "_" + cat.String()
+			if cat == Cool {
+				name = fmt.Sprintf("%s_cool-%03d", sprite, goldenCoolPersonalities[1])
+			}
+			t.Run(name, func(t *testing.T) {
+				if *update {
+					t.Skip("golden files are being updated")
+				}
+				c := canvas.FromImage(img)
+				palette, err := Apply(c, cat, Options{Personality: goldenCoolPersonalities[1]})
+				if err != nil {
+					t.Fatal(err)
+				}
+				checkGolden(t, name, c, palette)
+			})
+		}
+	}
+}
+
+func TestParseCategory(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		want    Category
+		wantErr bool
+	}{
+		{"cool", Cool, false},
+		{"Smart", Smart, false},
+		{" tough ", Tough, false},
+		{"BEAUTY", Beauty, false},
+		{"clever", 0, true},
+		{"", 0, true},
+	} {
+		got, err := ParseCategory(tt.name)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseCategory(%q) = %v, %v, want %v (error: %t)", tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestCategoryText(t *testing.T) {
+	for _, cat := range Categories() {
+		text, err := cat.MarshalText()
+		if err != nil || string(text) != cat.String() {
+			t.Errorf("%v.MarshalText() = %q, %v, want %q", cat, text, err, cat.String())
+		}
+	}
+	if got := Category(7).String(); got != "Category(7)" {
+		t.Errorf("Category(7).String() = %q, want \"Category(7)\"", got)
+	}
+	if _, err := Category(7).MarshalText(); err == nil {
+		t.Errorf("Category(7).MarshalText(): got no error")
+	}
+
+	type config struct {
+		Category Category `json:"category"`
+	}
+	data, err := json.Marshal(config{Category: Cute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"category":"cute"}` {
+		t.Errorf("json.Marshal = %s, want {\"category\":\"cute\"}", data)
+	}
+	var decoded config
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Category != Cute {
+		t.Errorf("json.Unmarshal(%s) = %v, %v, want cute", data, decoded.Category, err)
+	}
+	if err := json.Unmarshal([]byte(`{"category":"clever"}`), &decoded); err == nil {
+		t.Errorf("json.Unmarshal of an unknown category: got no error")
+	}
+	if _, err := json.Marshal(config{Category: Category(7)}); err == nil {
+		t.Errorf("json.Marshal of Category(7): got no error")
+	}
+}
+
+func TestApplyInvalidInput(t *testing.T) {
+	if _, err := Apply(canvas.New(0, 0), Smart, Options{}); err != canvas.ErrEmptyCanvas {
+		t.Errorf("empty canvas: got error %v, want ErrEmptyCanvas", err)
+	}
+
+	c := canvas.New(8, 8)
+	c.SetPixel(2, 3, canvas.RGB555{R: 32, A: 255})
+	if _, err := Apply(c, Smart, Options{}); err == nil {
+		t.Errorf("out-of-range channel: got no error")
+	} else if _, ok := err.(*canvas.ChannelError); !ok {
+		t.Errorf("out-of-range channel: got error %v, want a *canvas.ChannelError", err)
+	}
+
+	narrow := Options{Region: image.Rect(0, 0, 1, 8)}
+	if _, err := Apply(canvas.New(8, 8), Cool, narrow); err == nil {
+		t.Errorf("width-1 outline region: got no error")
+	} else if _, ok := err.(*effect.RegionSizeError); !ok {
+		t.Errorf("width-1 outline region: got error %v, want an *effect.RegionSizeError", err)
+	}
+	if _, err := Apply(canvas.New(8, 8), Beauty, narrow); err != nil {
+		t.Errorf("width-1 region without an outline: got error %v", err)
+	}
+	if _, err := Apply(canvas.New(64, 64), Cute, Options{Region: image.Rect(0, 0, 64, 63)}); err == nil {
+		t.Errorf("pointillism region smaller than 64x64: got no error")
+	} else if _, ok := err.(*effect.RegionSizeError); !ok {
+		t.Errorf("pointillism region smaller than 64x64: got error %v, want an *effect.RegionSizeError", err)
+	}
+}
+
+func TestApplySeededCuteEffect(t *testing.T) {
+	img := loadSprite(t, "charizard")
+	paint := func(seed int64) []byte {
+		c := canvas.FromImage(img)
+		return encodeGolden(c, ApplySeededCuteEffect(c, seed))
+	}
+	if !bytes.Equal(paint(1), paint(1)) {
+		t.Errorf("the same seed produced different paintings")
+	}
+	if bytes.Equal(paint(1), paint(2)) {
+		t.Errorf("different seeds produced the same painting")
+	}
+}
+
+func TestApplyTrace(t *testing.T) {
+	c := canvas.FromImage(loadSprite(t, "charizard"))
+	steps := &trace.Trace{}
+	palette, err := Apply(c, Smart, Options{Trace: steps})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"Original", "Black outline", "Blur right", "Blur down", "Black and white", "Blur", "Blur",
+		"Red channel grayscale", "Red channel highlight", "Grayscale quantization",
+	}
+	var got []string
+	for _, step := range steps.Steps {
+		got = append(got, step.Name)
+	}
+	if strings.Join(got, ", ") != strings.Join(want, ", ") {
+		t.Errorf("trace steps are %q, want %q", got, want)
+	}
+	last := steps.Steps[len(steps.Steps)-1]
+	if !bytes.Equal(encodeGolden(last.Canvas, last.Palette), encodeGolden(c, palette)) {
+		t.Errorf("the last trace step does not match the painting")
+	}
+}
+
+func TestImageProcessingSteps(t *testing.T) {
+	img := loadSprite(t, "charizard")
+	for _, tt := range []struct {
+		effect ImageEffect
+		steps  []string
+	}{
+		{ImageEffectNone, nil},
+		{ImageEffectPointillism, []string{"Pointillism"}},
+		{ImageEffectBlur, []string{"Blur"}},
+		{ImageEffectOutlineColored, []string{"Black outline", "Personality color"}},
+		// The game's missing break applies black and white twice.
+		{ImageEffectInvertBlackWhite, []string{"Black outline", "Invert", "Black and white", "Black and white"}},
+		{ImageEffectThickBlackWhite, []string{"Black and white"}},
+		{ImageEffectShimmer, []string{"Shimmer"}},
+		{ImageEffectOutline, []string{"Black outline"}},
+		{ImageEffectInvert, []string{"Invert"}},
+		{ImageEffectBlurRight, []string{"Blur right"}},
+		{ImageEffectBlurDown, []string{"Blur down"}},
+		{ImageEffectGrayscaleLight, []string{"Grayscale", "Red channel grayscale"}},
+		{ImageEffectCharcoal, []string{
+			"Black outline", "Blur right", "Blur down", "Black and white", "Blur", "Blur",
+			"Red channel grayscale", "Red channel highlight",
+		}},
+	} {
+		steps := &trace.Trace{}
+		context := &ImageProcessingContext{Canvas: canvas.FromImage(img), Effect: tt.effect, Trace: steps}
+		if err := ApplyImageProcessingEffects(context); err != nil {
+			t.Errorf("effect %d: %s", tt.effect, err)
+			continue
+		}
+		want := append([]string{"Original"}, tt.steps...)
+		var got []string
+		for _, step := range steps.Steps {
+			got = append(got, step.Name)
+		}
+		if strings.Join(got, ", ") != strings.Join(want, ", ") {
+			t.Errorf("effect %d: trace steps are %q, want %q", tt.effect, got, want)
+		}
+	}
+
+	for _, bad := range []ImageEffect{-1, ImageEffectCharcoal + 1} {
+		context := &ImageProcessingContext{Canvas: canvas.New(8, 8), Effect: bad}
+		if err := ApplyImageProcessingEffects(context); err == nil {
+			t.Errorf("invalid effect %d: got no error", bad)
+		}
+	}
+}
+
+func standardQuantization(maxColors int) func(canvas.Canvas, ...image.Rectangle) []canvas.RGB555 {
+	return func(c canvas.Canvas, region ...image.Rectangle) []canvas.RGB555 {
+		return paletteq.ApplyStandardQuantization(c, maxColors, region...)
+	}
+}
+
+func TestImageProcessingQuantization(t *testing.T) {
+	img := loadSprite(t, "charizard")
+	for _, tt := range []struct {
+		quantize  QuantizeEffect
+		step      string
+		numColors int
+		apply     func(c canvas.Canvas, region ...image.Rectangle) []canvas.RGB555
+	}{
+		{QuantizeEffectStandard, "Standard quantization", 256, standardQuantization(256)},
+		{QuantizeEffectStandardLimitedColors, "Standard quantization (224)", 224, standardQuantization(224)},
+		{QuantizeEffectPrimaryColors, "Primary colors quantization", 16, paletteq.ApplyPrimaryColorsQuantization},
+		{QuantizeEffectGrayscale, "Grayscale quantization", 33, paletteq.ApplyGrayscaleQuantization},
+		{QuantizeEffectGrayscaleSmall, "Small grayscale quantization", 16, paletteq.ApplyGrayscaleSmallQuantization},
+		{QuantizeEffectBlackWhite, "Black and white quantization", 3, paletteq.ApplyBlackAndWhiteQuantization},
+	} {
+		steps := &trace.Trace{}
+		context := &ImageProcessingContext{Canvas: canvas.FromImage(img), QuantizeEffect: tt.quantize, Trace: steps}
+		palette, err := ApplyImageProcessingQuantization(context)
+		if err != nil {
+			t.Errorf("quantize effect %d: %s", tt.quantize, err)
+			continue
+		}
+		if len(steps.Steps) != 1 || steps.Steps[0].Name != tt.step {
+			t.Errorf("quantize effect %d: trace has %d steps, want one named %q", tt.quantize, len(steps.Steps), tt.step)
+		}
+		if len(palette) != tt.numColors {
+			t.Errorf("quantize effect %d: palette has %d colors, want %d", tt.quantize, len(palette), tt.numColors)
+		}
+		plain := canvas.FromImage(img)
+		if !bytes.Equal(encodeGolden(context.Canvas, palette), encodeGolden(plain, tt.apply(plain))) {
+			t.Errorf("quantize effect %d does not match calling the quantizer directly", tt.quantize)
+		}
+	}
+
+	for _, bad := range []QuantizeEffect{-1, QuantizeEffectBlackWhite + 1} {
+		context := &ImageProcessingContext{Canvas: canvas.New(8, 8), QuantizeEffect: bad}
+		if _, err := ApplyImageProcessingQuantization(context); err == nil {
+			t.Errorf("invalid quantize effect %d: got no error", bad)
+		}
+	}
+}
+
+func loadSprite(t *testing.T, name string) image.Image {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", "sprites", name+".png"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return img
+}
+
+func checkGolden(t *testing.T, name string, c canvas.Canvas, palette []canvas.RGB555) {
+	t.Helper()
+	path := filepath.Join("testdata", "golden", name+".golden")
+	got := encodeGolden(c, palette)
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%s (run go test -update to create it)", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output does not match %s:\n%s", path, firstDifference(got, want))
+	}
+}
+
+// encodeGolden writes the palette and the canvas color indexes in a text
+// format, so that changes show up clearly in diffs.
+func encodeGolden(c canvas.Canvas, palette []canvas.RGB555) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "palette %d\n", len(palette))
+	for _, paletteColor := range palette {
+		fmt.Fprintf(&buf, "%d %d %d %d\n", paletteColor.R, paletteColor.G, paletteColor.B, paletteColor.A)
+	}
+	fmt.Fprintf(&buf, "indexes %dx%d\n", c.Width(), c.Height())
+	for y := 0; y < c.Height(); y++ {
+		row := make([]string, c.Width())
+		for x := range row {
+			row[x] = fmt.Sprintf("%02x", c.AtColorIndex(x, y))
+		}
+		fmt.Fprintln(&buf, strings.Join(row, " "))
+	}
+	return buf.Bytes()
+}
+
+func firstDifference(got, want []byte) string {
+	gotLines := bufio.NewScanner(bytes.NewReader(got))
+	wantLines := bufio.NewScanner(bytes.NewReader(want))
+	for line := 1; ; line++ {
+		gotOK, wantOK := gotLines.Scan(), wantLines.Scan()
+		if !gotOK && !wantOK {
+			return "no difference found"
+		}
+		if gotLines.Text() != wantLines.Text() || gotOK != wantOK {
+			return fmt.Sprintf("line %d:\n got: %s\nwant: %s", line, gotLines.Text(), wantLines.Text())
+		}
+	}
+}
@@ -1,7 +1,8 @@
 package effect
 
 import (
-	"image/color"
+	"fmt"
+	"image"
 
 	"github.com/huderlem/contest-painting-effects/canvas"
 	"github.com/huderlem/contest-painting-effects/pixelq"
@@ -9,10 +10,11 @@ import (
 
 // ApplyRedChannelGrayscale performs a grayscale effect on the canvas using
 // the red color channel. A delta value is added to the red channel.
-func ApplyRedChannelGrayscale(c canvas.Canvas, delta int) {
-	for x := 0; x < c.Width(); x++ {
-		for y := 0; y < c.Height(); y++ {
-			pixel := c.At(x, y)
+func ApplyRedChannelGrayscale(c canvas.Canvas, delta int, region ...image.Rectangle) {
+	r := c.Region(region...)
+	for x := r.Min.X; x < r.Max.X; x++ {
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			pixel := c.Pixel(x, y)
 			if pixel.A == 255 {
 				// Gets the grayscale value, based on the pixel's red channel.
 				// Also adds a delta to skew lighter or darker.
@@ -24,7 +26,7 @@ func ApplyRedChannelGrayscale(c canvas.Canvas, delta int) {
 				if grayValue > 31 {
 					grayValue = 31
 				}
-				c.Set(x, y, color.RGBA{uint8(grayValue), uint8(grayValue), uint8(grayValue), pixel.A})
+				c.SetPixel(x, y, canvas.RGB555{R: uint8(grayValue), G: uint8(grayValue), B: uint8(grayValue), A: pixel.A})
 			}
 		}
 	}
@@ -33,16 +35,17 @@ func ApplyRedChannelGrayscale(c canvas.Canvas, delta int) {
 // ApplyRedChannelGrayscaleHighlight performs a grayscale highlight effect
 // on the canvas using the red color channel. Brighter colors are clamped
 // according to the highlight threshold.
-func ApplyRedChannelGrayscaleHighlight(c canvas.Canvas, highlight int) {
-	for x := 0; x < c.Width(); x++ {
-		for y := 0; y < c.Height(); y++ {
-			pixel := c.At(x, y)
+func ApplyRedChannelGrayscaleHighlight(c canvas.Canvas, highlight int, region ...image.Rectangle) {
+	r := c.Region(region...)
+	for x := r.Min.X; x < r.Max.X; x++ {
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			pixel := c.Pixel(x, y)
 			if pixel.A == 255 {
 				grayValue := int(pixel.R)
 				if grayValue > 31-highlight {
 					grayValue = 31 - highlight/2
 				}
-				c.Set(x, y, color.RGBA{uint8(grayValue), uint8(grayValue), uint8(grayValue), pixel.A})
+				c.SetPixel(x, y, canvas.RGB555{R: uint8(grayValue), G: uint8(grayValue), B: uint8(grayValue), A: pixel.A})
 			}
 		}
 	}
@@ -50,13 +53,15 @@ func ApplyRedChannelGrayscaleHighlight(c canvas.Canvas, highlight int) {
 
 // ApplyGrayscale performs a grayscale effect on the canvas using a specific
 // weighting of each color channel.
-func ApplyGrayscale(c canvas.Canvas) {
-	for x := 0; x < c.Width(); x++ {
-		for y := 0; y < c.Height(); y++ {
-			pixel := c.At(x, y)
+func ApplyGrayscale(c canvas.Canvas, region ...image.Rectangle) {
+	r := c.Region(region...)
+	for x := r.Min.X; x < r.Max.X; x++ {
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			pixel := c.Pixel(x, y)
 			if pixel.A == 255 {
-				grayValue := float32(pixel.R)*0.3 + float32(pixel.G)*0.59 + float32(pixel.B)*0.1133
-				c.Set(x, y, color.RGBA{uint8(grayValue), uint8(grayValue), uint8(grayValue), pixel.A})
+				// The weights are 8.8 fixed-point values for 0.3, 0.59 and 0.1133.
+				grayValue := (int(pixel.R)*76 + int(pixel.G)*151 + int(pixel.B)*29) >> 8
+				c.SetPixel(x, y, canvas.RGB555{R: uint8(grayValue), G: uint8(grayValue), B: uint8(grayValue), A: pixel.A})
 			}
 		}
 	}
@@ -66,18 +71,19 @@ func ApplyGrayscale(c canvas.Canvas) {
 // blur.  Instead, it only considers the two pixels above and below the pixel
 // in question and attempts to reconcile their RGB differences. The result is
 // more of a "smudge" than a "blur".
-func ApplyBlur(c canvas.Canvas) {
-	for x := 0; x < c.Width(); x++ {
-		prevPixel := c.At(x, 0)
-		for y := 1; y < c.Height()-1; y++ {
-			pixel := c.At(x, y)
+func ApplyBlur(c canvas.Canvas, region ...image.Rectangle) {
+	r := c.Region(region...)
+	for x := r.Min.X; x < r.Max.X; x++ {
+		prevPixel := c.Pixel(x, r.Min.Y)
+		for y := r.Min.Y + 1; y < r.Max.Y-1; y++ {
+			pixel := c.Pixel(x, y)
 			if pixel.A == 255 {
-				nextPixel := c.At(x, y+1)
+				nextPixel := c.Pixel(x, y+1)
 				blurredPixel := pixelq.Blur(prevPixel, pixel, nextPixel)
-				c.Set(x, y, blurredPixel)
+				c.SetPixel(x, y, blurredPixel)
 				prevPixel = blurredPixel
 			} else {
-				c.Set(x, y, color.RGBA{0, 0, 0, 0})
+				c.SetPixel(x, y, canvas.RGB555{R: 0, G: 0, B: 0, A: 0})
 			}
 		}
 	}
@@ -87,12 +93,13 @@ func ApplyBlur(c canvas.Canvas) {
 // Lighter colors are turned white. The personality value determines what color to use
 // for darker colors. In Pokémon Emerald, this is the lower 8 bits of the mon's
 // personality value.
-func ApplyPersonalityColor(c canvas.Canvas, personality uint8) {
-	for x := 0; x < c.Width(); x++ {
-		for y := 0; y < c.Height(); y++ {
-			pixel := c.At(x, y)
+func ApplyPersonalityColor(c canvas.Canvas, personality uint8, region ...image.Rectangle) {
+	r := c.Region(region...)
+	for x := r.Min.X; x < r.Max.X; x++ {
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			pixel := c.Pixel(x, y)
 			if pixel.A == 255 {
-				c.Set(x, y, pixelq.PersonalityColor(pixel, personality))
+				c.SetPixel(x, y, pixelq.PersonalityColor(pixel, personality))
 			}
 		}
 	}
@@ -100,53 +107,85 @@ func ApplyPersonalityColor(c canvas.Canvas, personality uint8) {
 
 // ApplyBlackAndWhite converts all colors to each black or white, depending on the
 // pixel's average color channel value.
-func ApplyBlackAndWhite(c canvas.Canvas) {
-	for x := 0; x < c.Width(); x++ {
-		for y := 0; y < c.Height(); y++ {
-			pixel := c.At(x, y)
+func ApplyBlackAndWhite(c canvas.Canvas, region ...image.Rectangle) {
+	r := c.Region(region...)
+	for x := r.Min.X; x < r.Max.X; x++ {
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			pixel := c.Pixel(x, y)
 			if pixel.A == 255 {
-				c.Set(x, y, pixelq.BlackAndWhite(pixel))
+				c.SetPixel(x, y, pixelq.BlackAndWhite(pixel))
 			}
 		}
 	}
 }
 
+// RegionSizeError is returned by ValidateOutline and ValidatePointillism
+// for a region that is too small for the effect.
+type RegionSizeError struct {
+	Region image.Rectangle
+	// Effect is the name of the effect, like "outline".
+	Effect string
+	// MinSize is the smallest region size the effect can be applied to.
+	MinSize image.Point
+}
+
+func (e *RegionSizeError) Error() string {
+	return fmt.Sprintf("region %v is too small for the %s effect, which needs at least %dx%d pixels", e.Region, e.Effect, e.MinSize.X, e.MinSize.Y)
+}
+
+// ValidateOutline checks that ApplyBlackOutline can be applied to the
+// region of the canvas. The outline compares each edge pixel with its
+// neighbor, so regions narrower or shorter than 2 pixels would read and
+// write pixels outside of the region. It returns a *RegionSizeError for
+// such regions.
+func ValidateOutline(c canvas.Canvas, region ...image.Rectangle) error {
+	r := c.Region(region...)
+	if r.Dx() < 2 || r.Dy() < 2 {
+		return &RegionSizeError{Region: r, Effect: "outline", MinSize: image.Pt(2, 2)}
+	}
+	return nil
+}
+
 // ApplyBlackOutline performs an outline effect on the canvas. All pixels that border
 // transparency are changed to black.
-func ApplyBlackOutline(c canvas.Canvas) {
-	for y := 0; y < c.Height(); y++ {
-		c.Set(0, y, pixelq.BlackOutline(c.At(0, y), c.At(1, y)))
-		for x := 1; x < c.Width()-1; x++ {
-			rightOutline := pixelq.BlackOutline(c.At(x, y), c.At(x+1, y))
-			c.Set(x, y, rightOutline)
-			leftOutline := pixelq.BlackOutline(c.At(x, y), c.At(x-1, y))
-			c.Set(x, y, leftOutline)
+func ApplyBlackOutline(c canvas.Canvas, region ...image.Rectangle) {
+	r := c.Region(region...)
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		left := r.Min.X
+		c.SetPixel(left, y, pixelq.BlackOutline(c.Pixel(left, y), c.Pixel(left+1, y)))
+		for x := r.Min.X + 1; x < r.Max.X-1; x++ {
+			rightOutline := pixelq.BlackOutline(c.Pixel(x, y), c.Pixel(x+1, y))
+			c.SetPixel(x, y, rightOutline)
+			leftOutline := pixelq.BlackOutline(c.Pixel(x, y), c.Pixel(x-1, y))
+			c.SetPixel(x, y, leftOutline)
 		}
-		right := c.Width() - 1
-		c.Set(right, y, pixelq.BlackOutline(c.At(right, y), c.At(right-1, y)))
-	}
-	for x := 0; x < c.Width(); x++ {
-		c.Set(x, 0, pixelq.BlackOutline(c.At(x, 0), c.At(x, 1)))
-		for y := 1; y < c.Height()-1; y++ {
-			c.Set(x, y, pixelq.BlackOutline(c.At(x, y), c.At(x, y+1)))
-			c.Set(x, y, pixelq.BlackOutline(c.At(x, y), c.At(x, y-1)))
+		right := r.Max.X - 1
+		c.SetPixel(right, y, pixelq.BlackOutline(c.Pixel(right, y), c.Pixel(right-1, y)))
+	}
+	for x := r.Min.X; x < r.Max.X; x++ {
+		top := r.Min.Y
+		c.SetPixel(x, top, pixelq.BlackOutline(c.Pixel(x, top), c.Pixel(x, top+1)))
+		for y := r.Min.Y + 1; y < r.Max.Y-1; y++ {
+			c.SetPixel(x, y, pixelq.BlackOutline(c.Pixel(x, y), c.Pixel(x, y+1)))
+			c.SetPixel(x, y, pixelq.BlackOutline(c.Pixel(x, y), c.Pixel(x, y-1)))
 		}
-		bottom := c.Height() - 1
-		c.Set(x, bottom, pixelq.BlackOutline(c.At(x, bottom), c.At(x, bottom-1)))
+		bottom := r.Max.Y - 1
+		c.SetPixel(x, bottom, pixelq.BlackOutline(c.Pixel(x, bottom), c.Pixel(x, bottom-1)))
 	}
 }
 
 // ApplyInvert performs a negative effect on the canvas. All pixel colors are
 // inverted.
-func ApplyInvert(c canvas.Canvas) {
-	for y := 0; y < c.Height(); y++ {
-		for x := 0; x < c.Width(); x++ {
-			pixel := c.At(x, y)
+func ApplyInvert(c canvas.Canvas, region ...image.Rectangle) {
+	r := c.Region(region...)
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			pixel := c.Pixel(x, y)
 			if pixel.A == 255 {
-				invertedPixel := pixelq.Invert(c.At(x, y))
-				c.Set(x, y, invertedPixel)
+				invertedPixel := pixelq.Invert(c.Pixel(x, y))
+				c.SetPixel(x, y, invertedPixel)
 			} else {
-				c.Set(x, y, color.RGBA{0, 0, 0, 0})
+				c.SetPixel(x, y, canvas.RGB555{R: 0, G: 0, B: 0, A: 0})
 			}
 		}
 	}
@@ -154,44 +193,36 @@ func ApplyInvert(c canvas.Canvas) {
 
 // ApplyShimmer performs a shimmering effect on the canvas. The edges become
 // very light, and it sort of looks like a mirage(?).
-func ApplyShimmer(c canvas.Canvas) {
+func ApplyShimmer(c canvas.Canvas, region ...image.Rectangle) {
+	r := c.Region(region...)
 	// First, invert all of the colors.
-	ApplyInvert(c)
+	ApplyInvert(c, r)
 
-	// Blur the pixels twice.
-	for x := 0; x < c.Width(); x++ {
-		prevPixel := c.At(x, 0)
-		for y := 1; y < c.Height()-1; y++ {
-			pixel := c.At(x, y)
-			if pixel.A == 255 {
-				nextPixel := c.At(x, y+1)
-				blurredPixel := pixelq.BlurHard(prevPixel, pixel, nextPixel)
-				c.Set(x, y, blurredPixel)
-				prevPixel = blurredPixel
-			} else {
-				c.Set(x, y, color.RGBA{0, 0, 0, 0})
-			}
-		}
-	}
-	for x := 0; x < c.Width(); x++ {
-		prevPixel := c.At(x, 0)
-		for y := 1; y < c.Height()-1; y++ {
-			pixel := c.At(x, y)
-			if pixel.A == 255 {
-				nextPixel := c.At(x, y+1)
-				blurredPixel := pixelq.BlurHard(prevPixel, pixel, nextPixel)
-				c.Set(x, y, blurredPixel)
-				prevPixel = blurredPixel
-			} else {
-				c.Set(x, y, color.RGBA{0, 0, 0, 0})
+	// Blur the pixels twice. The top and bottom pixels of each column
+	// are made transparent before and after each pass.
+	for x := r.Min.X; x < r.Max.X; x++ {
+		for pass := 0; pass < 2; pass++ {
+			prevPixel := c.Pixel(x, r.Min.Y)
+			c.SetPixel(x, r.Min.Y, canvas.RGB555{R: 0, G: 0, B: 0, A: 0})
+			for y := r.Min.Y + 1; y < r.Max.Y-1; y++ {
+				pixel := c.Pixel(x, y)
+				if pixel.A == 255 {
+					nextPixel := c.Pixel(x, y+1)
+					blurredPixel := pixelq.BlurHard(prevPixel, pixel, nextPixel)
+					c.SetPixel(x, y, blurredPixel)
+					prevPixel = blurredPixel
+				} else {
+					c.SetPixel(x, y, canvas.RGB555{R: 0, G: 0, B: 0, A: 0})
+				}
 			}
+			c.SetPixel(x, r.Max.Y-1, canvas.RGB555{R: 0, G: 0, B: 0, A: 0})
 		}
 	}
 
 	// Finally, invert colors back to the original color space.
 	// The above blur causes the outline areas to darken, which makes
 	// this inversion give the effect of light outlines.
-	ApplyInvert(c)
+	ApplyInvert(c, r)
 }
 
 // ApplyBlurRight performs a right-direction motion blur effect on
@@ -199,14 +230,15 @@ func ApplyShimmer(c canvas.Canvas) {
 // pixel directly to the right of the pixel in question and attempts to
 // reconcile their RGB differences. The result is more of a "smudge" than
 // a "blur".
-func ApplyBlurRight(c canvas.Canvas) {
-	for y := 0; y < c.Height(); y++ {
-		prevPixel := c.At(0, y)
-		for x := 1; x < c.Width()-1; x++ {
-			pixel := c.At(x, y)
+func ApplyBlurRight(c canvas.Canvas, region ...image.Rectangle) {
+	r := c.Region(region...)
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		prevPixel := c.Pixel(r.Min.X, y)
+		for x := r.Min.X + 1; x < r.Max.X-1; x++ {
+			pixel := c.Pixel(x, y)
 			if pixel.A == 255 {
 				blurredPixel := pixelq.MotionBlur(prevPixel, pixel)
-				c.Set(x, y, blurredPixel)
+				c.SetPixel(x, y, blurredPixel)
 				prevPixel = blurredPixel
 			}
 		}
@@ -217,24 +249,60 @@ func ApplyBlurRight(c canvas.Canvas) {
 // canvas. This is not a gaussian blur.  Instead, it only considers
 // pixel directly beneath the pixel in question and attempts to reconcile
 // their RGB differences. The result is more of a "smudge" than a "blur".
-func ApplyBlurDown(c canvas.Canvas) {
-	for x := 0; x < c.Width(); x++ {
-		prevPixel := c.At(x, 0)
-		for y := 1; y < c.Height()-1; y++ {
-			pixel := c.At(x, y)
+func ApplyBlurDown(c canvas.Canvas, region ...image.Rectangle) {
+	r := c.Region(region...)
+	for x := r.Min.X; x < r.Max.X; x++ {
+		prevPixel := c.Pixel(x, r.Min.Y)
+		for y := r.Min.Y + 1; y < r.Max.Y-1; y++ {
+			pixel := c.Pixel(x, y)
 			if pixel.A == 255 {
 				blurredPixel := pixelq.MotionBlur(prevPixel, pixel)
-				c.Set(x, y, blurredPixel)
+				c.SetPixel(x, y, blurredPixel)
 				prevPixel = blurredPixel
 			}
 		}
 	}
 }
 
+// ValidatePointillism checks that ApplyPointillism can be applied to the
+// region of the canvas. The pointillism table only covers a 64x64 tile, and
+// the dots are drawn on every whole tile of the region, so regions narrower
+// or shorter than 64 pixels would get no dots. It returns a
+// *RegionSizeError for such regions.
+func ValidatePointillism(c canvas.Canvas, region ...image.Rectangle) error {
+	r := c.Region(region...)
+	if r.Dx() < pixelq.PointillismTileSize || r.Dy() < pixelq.PointillismTileSize {
+		return &RegionSizeError{Region: r, Effect: "pointillism", MinSize: image.Pt(pixelq.PointillismTileSize, pixelq.PointillismTileSize)}
+	}
+	return nil
+}
+
 // ApplyPointillism performs a pointillism effect on the canvas, so
-// it looks like the image was drawn with a individual tiny dots.
-func ApplyPointillism(c canvas.Canvas) {
-	for i := 0; i < 3200; i++ {
-		pixelq.AddPointillismPoints(c, i)
+// it looks like the image was drawn with a individual tiny dots. The dots
+// are drawn on each whole 64x64 tile of the region, starting at its top-left
+// corner. Pixels past the last whole tile, and regions smaller than 64x64
+// pixels, get no dots; see ValidatePointillism.
+func ApplyPointillism(c canvas.Canvas, region ...image.Rectangle) {
+	r := c.Region(region...)
+	for i := 0; i < pixelq.NumPointillismPoints; i++ {
+		pixelq.AddPointillismPoints(c, i, r)
+	}
+}
+
+// ApplyPointillismWithTable is like ApplyPointillism, but uses the points
+// of the given pointillism table, such as one made by
+// pixelq.GeneratePointillismTable. It returns an error, and leaves the
+// canvas unchanged, if the table is not valid according to
+// pixelq.ValidatePointillismTable.
+func ApplyPointillismWithTable(c canvas.Canvas, table []uint8, region ...image.Rectangle) error {
+	if err := pixelq.ValidatePointillismTable(table); err != nil {
+		return err
+	}
+	r := c.Region(region...)
+	for i := 0; i < len(table)/3; i++ {
+		if err := pixelq.AddPointillismPointsFromTable(c, table, i, r); err != nil {
+			return err
+		}
 	}
+	return nil
 }
@@ -0,0 +1,158 @@
+package effect
+
+import (
+	"image"
+	"testing"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+)
+
+func filledCanvas(width, height int, fill canvas.RGB555) canvas.Canvas {
+	c := canvas.New(width, height)
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			c.SetPixel(x, y, fill)
+		}
+	}
+	return c
+}
+
+func TestApplyGrayscaleFixedPoint(t *testing.T) {
+	// The game weighs red by 76/256, not 0.3, so a red value of 10 becomes
+	// a gray value of 2 instead of 3.
+	c := filledCanvas(1, 1, canvas.RGB555{R: 10, A: 255})
+	ApplyGrayscale(c)
+	if got, want := c.Pixel(0, 0), (canvas.RGB555{R: 2, G: 2, B: 2, A: 255}); got != want {
+		t.Errorf("ApplyGrayscale: got %v, want %v", got, want)
+	}
+}
+
+func TestApplyShimmerColumnEnds(t *testing.T) {
+	c := filledCanvas(4, 8, canvas.RGB555{R: 10, G: 20, B: 30, A: 255})
+	ApplyShimmer(c)
+	for x := 0; x < 4; x++ {
+		for _, y := range []int{0, 7} {
+			if got := c.Pixel(x, y); got.A != 0 {
+				t.Errorf("pixel (%d, %d) is %v, want transparent", x, y, got)
+			}
+		}
+		if got := c.Pixel(x, 1); got.A != 255 {
+			t.Errorf("pixel (%d, 1) is %v, want opaque", x, got)
+		}
+	}
+}
+
+func TestApplyPointillismStaysInRegion(t *testing.T) {
+	// Dot lines that move past the top or left edge of the region end there,
+	// like the game's unsigned byte coordinates, instead of drawing outside it.
+	fill := canvas.RGB555{R: 31, A: 255}
+	for _, region := range []image.Rectangle{
+		image.Rect(64, 0, 128, 64),
+		image.Rect(0, 64, 64, 128),
+	} {
+		c := filledCanvas(128, 128, fill)
+		ApplyPointillism(c, region)
+		for x := 0; x < 128; x++ {
+			for y := 0; y < 128; y++ {
+				if !image.Pt(x, y).In(region) && c.Pixel(x, y) != fill {
+					t.Fatalf("region %v: pixel (%d, %d) outside the region changed to %v", region, x, y, c.Pixel(x, y))
+				}
+			}
+		}
+	}
+}
+
+func TestValidatePointillism(t *testing.T) {
+	c := canvas.New(128, 80)
+	if err := ValidatePointillism(c); err != nil {
+		t.Errorf("128x80 canvas: got error %v", err)
+	}
+	for _, region := range []image.Rectangle{
+		image.Rect(0, 0, 63, 64),
+		image.Rect(0, 20, 128, 80),
+	} {
+		err := ValidatePointillism(c, region)
+		if e, ok := err.(*RegionSizeError); !ok || e.Region != region {
+			t.Errorf("region %v: got error %v, want a *RegionSizeError", region, err)
+		}
+	}
+}
+
+func TestApplyPointillismWithMalformedTable(t *testing.T) {
+	fill := canvas.RGB555{R: 20, G: 20, B: 20, A: 255}
+	c := filledCanvas(64, 64, fill)
+	// The first point is valid, but the second has 7 dots, so nothing is
+	// drawn.
+	table := []uint8{10, 10, 3 << 3, 10, 10, 7 << 3}
+	if err := ApplyPointillismWithTable(c, table); err == nil {
+		t.Errorf("table with 7 dots: got no error")
+	}
+	if err := ApplyPointillismWithTable(c, table[:4]); err == nil {
+		t.Errorf("table with a partial point: got no error")
+	}
+	for x := 0; x < 64; x++ {
+		for y := 0; y < 64; y++ {
+			if c.Pixel(x, y) != fill {
+				t.Fatalf("pixel (%d, %d) changed", x, y)
+			}
+		}
+	}
+}
+
+// patternCanvas returns a canvas with varied colors and some transparent
+// pixels.
+func patternCanvas(width, height int) canvas.Canvas {
+	c := canvas.New(width, height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			pixel := canvas.RGB555{R: uint8(x % 32), G: uint8(y % 32), B: uint8((x + y) % 32), A: 255}
+			if (x*7+y*3)%11 == 0 {
+				pixel.A = 0
+			}
+			c.SetPixel(x, y, pixel)
+		}
+	}
+	return c
+}
+
+func TestEffectsStayInRegion(t *testing.T) {
+	region := image.Rect(40, 30, 104, 94)
+	effects := []struct {
+		name  string
+		apply func(c canvas.Canvas)
+	}{
+		{"RedChannelGrayscale", func(c canvas.Canvas) { ApplyRedChannelGrayscale(c, 3, region) }},
+		{"RedChannelGrayscaleHighlight", func(c canvas.Canvas) { ApplyRedChannelGrayscaleHighlight(c, 4, region) }},
+		{"Grayscale", func(c canvas.Canvas) { ApplyGrayscale(c, region) }},
+		{"Blur", func(c canvas.Canvas) { ApplyBlur(c, region) }},
+		{"PersonalityColor", func(c canvas.Canvas) { ApplyPersonalityColor(c, 7, region) }},
+		{"BlackAndWhite", func(c canvas.Canvas) { ApplyBlackAndWhite(c, region) }},
+		{"BlackOutline", func(c canvas.Canvas) { ApplyBlackOutline(c, region) }},
+		{"Invert", func(c canvas.Canvas) { ApplyInvert(c, region) }},
+		{"Shimmer", func(c canvas.Canvas) { ApplyShimmer(c, region) }},
+		{"BlurRight", func(c canvas.Canvas) { ApplyBlurRight(c, region) }},
+		{"BlurDown", func(c canvas.Canvas) { ApplyBlurDown(c, region) }},
+		{"Pointillism", func(c canvas.Canvas) { ApplyPointillism(c, region) }},
+	}
+	for _, e := range effects {
+		original := patternCanvas(128, 128)
+		c := original.Clone()
+		e.apply(c)
+		changed := false
+		for y := 0; y < 128; y++ {
+			for x := 0; x < 128; x++ {
+				if c.Pixel(x, y) == original.Pixel(x, y) {
+					continue
+				}
+				if !image.Pt(x, y).In(region) {
+					t.Errorf("%s: pixel (%d, %d) outside the region changed from %v to %v", e.name, x, y, original.Pixel(x, y), c.Pixel(x, y))
+					break
+				}
+				changed = true
+			}
+		}
+		if !changed {
+			t.Errorf("%s: no pixels in the region changed", e.name)
+		}
+	}
+}
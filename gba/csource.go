@@ -0,0 +1,152 @@
+package gba
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+)
+
+// CSourceOptions configures the C source and header written by WriteCSource
+// and WriteCHeader.
+type CSourceOptions struct {
+	// Symbol is the base name of the C arrays. The tiles, palette and
+	// tilemap are named Symbol_Gfx, Symbol_Pal and Symbol_Tilemap. It must
+	// be a valid C identifier, like gContestPainting.
+	Symbol string
+	// Depth is the tile bit depth. Defaults to DepthForPalette.
+	Depth BitDepth
+	// PaletteBank is the palette bank stored in the tilemap entries.
+	PaletteBank int
+	// Header is the name of the header file included by the C source, such
+	// as "contest_painting.h". Nothing is included if it is empty, apart
+	// from the project's global.h, which defines u16 and u32.
+	Header string
+}
+
+// DepthForPalette returns the smallest tile bit depth that can index every
+// color of the palette: 4bpp for palettes that fit in a single palette
+// bank, and 8bpp otherwise.
+func DepthForPalette(palette []canvas.RGB555) BitDepth {
+	if len(palette) <= 16 {
+		return BitDepth4
+	}
+	return BitDepth8
+}
+
+// WriteCSource writes a C source file, in the style of pokeemerald-based
+// projects, with the canvas tiles, palette and tilemap as literal arrays.
+// The tiles are stored as u32 words, which is how the game's graphics are
+// declared with INCBIN_U32.
+func WriteCSource(w io.Writer, c canvas.Canvas, palette []canvas.RGB555, opts CSourceOptions) error {
+	if err := checkSymbol(opts.Symbol); err != nil {
+		return err
+	}
+	depth := opts.Depth
+	if depth == 0 {
+		depth = DepthForPalette(palette)
+	}
+	tiles, err := Tiles(c, depth)
+	if err != nil {
+		return err
+	}
+	tilemap, err := Tilemap(c, opts.PaletteBank)
+	if err != nil {
+		return err
+	}
+
+	words := make([]string, len(tiles)/4)
+	for i := range words {
+		words[i] = fmt.Sprintf("0x%08X", binary.LittleEndian.Uint32(tiles[i*4:]))
+	}
+	colors := make([]string, len(palette))
+	for i, paletteColor := range palette {
+		colors[i] = fmt.Sprintf("0x%04X", paletteColor.BGR555())
+	}
+	entries := make([]string, len(tilemap)/2)
+	for i := range entries {
+		entries[i] = fmt.Sprintf("0x%04X", binary.LittleEndian.Uint16(tilemap[i*2:]))
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "#include \"global.h\"\n")
+	if opts.Header != "" {
+		fmt.Fprintf(bw, "#include \"%s\"\n", opts.Header)
+	}
+	fmt.Fprintf(bw, "\n// %dx%d pixels, %dbpp tiles.\n", c.Width(), c.Height(), int(depth))
+	writeCArray(bw, "u32", opts.Symbol+"_Gfx", words, 8)
+	fmt.Fprintln(bw)
+	writeCArray(bw, "u16", opts.Symbol+"_Pal", colors, 8)
+	fmt.Fprintln(bw)
+	writeCArray(bw, "u16", opts.Symbol+"_Tilemap", entries, 8)
+	return bw.Flush()
+}
+
+// WriteCHeader writes the C header declaring the arrays written by
+// WriteCSource. The include guard is named after the header file, like
+// GUARD_CONTEST_PAINTING_H, or after the symbol if there is no header name.
+func WriteCHeader(w io.Writer, opts CSourceOptions) error {
+	if err := checkSymbol(opts.Symbol); err != nil {
+		return err
+	}
+	guard := "GUARD_" + upperSnakeCase(opts.Symbol) + "_H"
+	if opts.Header != "" {
+		guard = "GUARD_" + strings.Map(guardRune, strings.ToUpper(opts.Header))
+	}
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "#ifndef %s\n#define %s\n\n", guard, guard)
+	fmt.Fprintf(bw, "extern const u32 %s_Gfx[];\n", opts.Symbol)
+	fmt.Fprintf(bw, "extern const u16 %s_Pal[];\n", opts.Symbol)
+	fmt.Fprintf(bw, "extern const u16 %s_Tilemap[];\n", opts.Symbol)
+	fmt.Fprintf(bw, "\n#endif // %s\n", guard)
+	return bw.Flush()
+}
+
+func writeCArray(w io.Writer, elemType, name string, values []string, perLine int) {
+	fmt.Fprintf(w, "const %s %s[] = {\n", elemType, name)
+	for i := 0; i < len(values); i += perLine {
+		end := i + perLine
+		if end > len(values) {
+			end = len(values)
+		}
+		fmt.Fprintf(w, "    %s,\n", strings.Join(values[i:end], ", "))
+	}
+	fmt.Fprintf(w, "};\n")
+}
+
+func checkSymbol(symbol string) error {
+	if symbol == "" {
+		return fmt.Errorf("no C symbol name given")
+	}
+	for i, r := range symbol {
+		if r > unicode.MaxASCII || !(r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r))) {
+			return fmt.Errorf("%q is not a valid C symbol name", symbol)
+		}
+	}
+	return nil
+}
+
+func guardRune(r rune) rune {
+	if r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+		return r
+	}
+	return '_'
+}
+
+// upperSnakeCase converts a camel case symbol, like gContestPainting, into
+// the upper snake case used by include guards, like G_CONTEST_PAINTING.
+func upperSnakeCase(symbol string) string {
+	var b strings.Builder
+	runes := []rune(symbol)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,61 @@
+package gba
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+)
+
+func TestWriteCSource(t *testing.T) {
+	c := canvas.New(8, 8)
+	c.SetColorIndex(0, 0, 1)
+	c.SetColorIndex(1, 0, 2)
+	palette := []canvas.RGB555{{}, {R: 31, A: 255}, {B: 31, A: 255}}
+	opts := CSourceOptions{Symbol: "gContestPainting", PaletteBank: 2, Header: "contest_painting.h"}
+
+	var source bytes.Buffer
+	if err := WriteCSource(&source, c, palette, opts); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"#include \"global.h\"\n#include \"contest_painting.h\"\n",
+		"// 8x8 pixels, 4bpp tiles.\n",
+		"const u32 gContestPainting_Gfx[] = {\n    0x00000021, 0x00000000,",
+		"const u16 gContestPainting_Pal[] = {\n    0x0000, 0x001F, 0x7C00,\n};\n",
+		"const u16 gContestPainting_Tilemap[] = {\n    0x2000,\n};\n",
+	} {
+		if !strings.Contains(source.String(), want) {
+			t.Errorf("C source does not contain %q:\n%s", want, source.String())
+		}
+	}
+
+	var header bytes.Buffer
+	if err := WriteCHeader(&header, opts); err != nil {
+		t.Fatal(err)
+	}
+	want := "#ifndef GUARD_CONTEST_PAINTING_H\n#define GUARD_CONTEST_PAINTING_H\n\n" +
+		"extern const u32 gContestPainting_Gfx[];\n" +
+		"extern const u16 gContestPainting_Pal[];\n" +
+		"extern const u16 gContestPainting_Tilemap[];\n" +
+		"\n#endif // GUARD_CONTEST_PAINTING_H\n"
+	if header.String() != want {
+		t.Errorf("C header is:\n%s\nwant:\n%s", header.String(), want)
+	}
+}
+
+func TestCSourceSymbol(t *testing.T) {
+	for _, symbol := range []string{"", "1painting", "contest-painting", "gPaintingé"} {
+		if err := WriteCHeader(&bytes.Buffer{}, CSourceOptions{Symbol: symbol}); err == nil {
+			t.Errorf("symbol %q: got no error", symbol)
+		}
+	}
+	var header bytes.Buffer
+	if err := WriteCHeader(&header, CSourceOptions{Symbol: "gMuseumPainting2"}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(header.String(), "#ifndef GUARD_G_MUSEUM_PAINTING2_H\n") {
+		t.Errorf("header without a file name has guard:\n%s", header.String())
+	}
+}
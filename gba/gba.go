@@ -0,0 +1,100 @@
+package gba
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+)
+
+// BitDepth is the number of bits used to store each pixel of a tile.
+type BitDepth int
+
+// The tile bit depths supported by the GBA.
+const (
+	BitDepth4 BitDepth = 4
+	BitDepth8 BitDepth = 8
+)
+
+// TileSize is the width and height of a GBA tile in pixels.
+const TileSize = 8
+
+// maxTiles is the number of tiles a tilemap entry can address.
+const maxTiles = 1024
+
+// Tiles converts the canvas pixel color indexes into GBA tile data, the same
+// way the game's ConvertImageProcessingToGBA lays them out. Tiles are ordered
+// left-to-right, then top-to-bottom. In 4bpp tiles, the left pixel of each
+// pair is stored in the low nibble.
+func Tiles(c canvas.Canvas, depth BitDepth) ([]byte, error) {
+	if err := checkTileDimensions(c); err != nil {
+		return nil, err
+	}
+	if depth != BitDepth4 && depth != BitDepth8 {
+		return nil, fmt.Errorf("unsupported bit depth %d", int(depth))
+	}
+
+	maxIndex := 1<<uint(depth) - 1
+	bounds := c.Bounds()
+	data := make([]byte, 0, bounds.Dx()*bounds.Dy()*int(depth)/8)
+	for tileY := bounds.Min.Y; tileY < bounds.Max.Y; tileY += TileSize {
+		for tileX := bounds.Min.X; tileX < bounds.Max.X; tileX += TileSize {
+			for y := tileY; y < tileY+TileSize; y++ {
+				for x := tileX; x < tileX+TileSize; x++ {
+					index := c.AtColorIndex(x, y)
+					if index < 0 || index > maxIndex {
+						return nil, fmt.Errorf("color index %d at (%d, %d) does not fit in a %dbpp tile", index, x, y, int(depth))
+					}
+					if depth == BitDepth8 {
+						data = append(data, byte(index))
+					} else if (x-tileX)%2 == 0 {
+						data = append(data, byte(index))
+					} else {
+						data[len(data)-1] |= byte(index << 4)
+					}
+				}
+			}
+		}
+	}
+	return data, nil
+}
+
+// Palette converts a palette into GBA BGR555 color data, which is the
+// format of .gbapal files. Each color is stored as a little-endian 16-bit
+// value.
+func Palette(palette []canvas.RGB555) []byte {
+	data := make([]byte, len(palette)*2)
+	for i, paletteColor := range palette {
+		binary.LittleEndian.PutUint16(data[i*2:], paletteColor.BGR555())
+	}
+	return data
+}
+
+// Tilemap builds a tilemap for the tile data returned by Tiles. Each entry is
+// a little-endian 16-bit value referencing the tile at the same position,
+// numbered from 0. The palette bank is only used by 4bpp backgrounds, and is
+// ignored by the hardware for 8bpp backgrounds.
+func Tilemap(c canvas.Canvas, paletteBank int) ([]byte, error) {
+	if err := checkTileDimensions(c); err != nil {
+		return nil, err
+	}
+	if paletteBank < 0 || paletteBank > 15 {
+		return nil, fmt.Errorf("palette bank %d is out of range", paletteBank)
+	}
+	numTiles := (c.Width() / TileSize) * (c.Height() / TileSize)
+	if numTiles > maxTiles {
+		return nil, fmt.Errorf("canvas has %d tiles, but a tilemap can only reference %d", numTiles, maxTiles)
+	}
+	data := make([]byte, numTiles*2)
+	for i := 0; i < numTiles; i++ {
+		binary.LittleEndian.PutUint16(data[i*2:], uint16(i)|uint16(paletteBank)<<12)
+	}
+	return data, nil
+}
+
+func checkTileDimensions(c canvas.Canvas) error {
+	if c.Width()%TileSize != 0 || c.Height()%TileSize != 0 {
+		return fmt.Errorf("canvas size %dx%d is not a multiple of the %dx%d tile size", c.Width(), c.Height(), TileSize, TileSize)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package gba
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+)
+
+func TestTiles4bpp(t *testing.T) {
+	// Two tiles side by side. The left pixel of each pair is stored in the
+	// low nibble.
+	c := canvas.New(16, 8)
+	c.SetColorIndex(0, 0, 1)
+	c.SetColorIndex(1, 0, 2)
+	c.SetColorIndex(7, 7, 15)
+	c.SetColorIndex(8, 0, 3)
+	want := make([]byte, 64)
+	want[0] = 0x21
+	want[31] = 0xF0
+	want[32] = 0x03
+
+	got, err := Tiles(c, BitDepth4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Tiles(4bpp) = % x, want % x", got, want)
+	}
+
+	c.SetColorIndex(2, 0, 16)
+	if _, err := Tiles(c, BitDepth4); err == nil {
+		t.Errorf("Tiles(4bpp) with color index 16: got no error")
+	}
+}
+
+func TestTiles8bpp(t *testing.T) {
+	// Two tiles stacked vertically, with one byte per pixel.
+	c := canvas.New(8, 16)
+	c.SetColorIndex(0, 0, 200)
+	c.SetColorIndex(7, 0, 17)
+	c.SetColorIndex(1, 1, 255)
+	c.SetColorIndex(0, 8, 5)
+	want := make([]byte, 128)
+	want[0] = 200
+	want[7] = 17
+	want[9] = 255
+	want[64] = 5
+
+	got, err := Tiles(c, BitDepth8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Tiles(8bpp) = % x, want % x", got, want)
+	}
+
+	if _, err := Tiles(canvas.New(8, 12), BitDepth8); err == nil {
+		t.Errorf("Tiles with an 8x12 canvas: got no error")
+	}
+	if _, err := Tiles(c, BitDepth(2)); err == nil {
+		t.Errorf("Tiles with a 2bpp depth: got no error")
+	}
+}
+
+func TestPalette(t *testing.T) {
+	palette := []canvas.RGB555{
+		{},
+		{R: 31, A: 255},
+		{G: 31, A: 255},
+		{B: 31, A: 255},
+		{R: 1, G: 2, B: 3, A: 255},
+	}
+	want := []byte{0x00, 0x00, 0x1F, 0x00, 0xE0, 0x03, 0x00, 0x7C, 0x41, 0x0C}
+	if got := Palette(palette); !bytes.Equal(got, want) {
+		t.Errorf("Palette = % x, want % x", got, want)
+	}
+}
+
+func TestTilemap(t *testing.T) {
+	got, err := Tilemap(canvas.New(16, 16), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x00, 0x30, 0x01, 0x30, 0x02, 0x30, 0x03, 0x30}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Tilemap = % x, want % x", got, want)
+	}
+
+	if _, err := Tilemap(canvas.New(16, 16), 16); err == nil {
+		t.Errorf("Tilemap with palette bank 16: got no error")
+	}
+}
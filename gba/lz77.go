@@ -0,0 +1,126 @@
+package gba
+
+import "fmt"
+
+// lz77Type is the first header byte of LZ77-compressed data, which the
+// BIOS decompression routines check.
+const lz77Type = 0x10
+
+// maxLZ77Size is the largest decompressed size the 24-bit header can hold.
+const maxLZ77Size = 1<<24 - 1
+
+// LZ77 back-references copy 3 to 18 bytes, from 1 to 4096 bytes back.
+const (
+	lz77MinLength   = 3
+	lz77MaxLength   = 18
+	lz77MaxDistance = 4096
+)
+
+// CompressLZ77 compresses data in the GBA BIOS LZ77 format (type 0x10),
+// the format of the game's .lz assets. The output can be decompressed with
+// the BIOS LZ77UnCompWram routine. It is padded with zeros to a multiple of
+// 4 bytes, like the game's build tools do.
+func CompressLZ77(data []byte) ([]byte, error) {
+	return compressLZ77(data, 1)
+}
+
+// CompressLZ77VRAMSafe is like CompressLZ77, but never copies from the byte
+// just before the current one. The BIOS LZ77UnCompVram routine writes
+// 16 bits at a time, so it cannot decompress such copies. The output works
+// with both BIOS routines.
+func CompressLZ77VRAMSafe(data []byte) ([]byte, error) {
+	return compressLZ77(data, 2)
+}
+
+func compressLZ77(data []byte, minDistance int) ([]byte, error) {
+	if len(data) > maxLZ77Size {
+		return nil, fmt.Errorf("data is %d bytes, but LZ77 data can only hold %d", len(data), maxLZ77Size)
+	}
+	out := []byte{lz77Type, byte(len(data)), byte(len(data) >> 8), byte(len(data) >> 16)}
+	flagsIndex, flagBit := 0, 0
+	for pos := 0; pos < len(data); {
+		if flagBit == 0 {
+			flagsIndex = len(out)
+			out = append(out, 0)
+			flagBit = 0x80
+		}
+		length, distance := longestMatch(data, pos, minDistance)
+		if length >= lz77MinLength {
+			out[flagsIndex] |= byte(flagBit)
+			out = append(out, byte((length-lz77MinLength)<<4|(distance-1)>>8), byte(distance-1))
+			pos += length
+		} else {
+			out = append(out, data[pos])
+			pos++
+		}
+		flagBit >>= 1
+	}
+	for len(out)%4 != 0 {
+		out = append(out, 0)
+	}
+	return out, nil
+}
+
+// longestMatch finds the longest earlier copy of the bytes at pos. Matches
+// may overlap pos, which repeats the copied bytes. The nearest match wins
+// ties.
+func longestMatch(data []byte, pos, minDistance int) (length, distance int) {
+	maxLength := len(data) - pos
+	if maxLength > lz77MaxLength {
+		maxLength = lz77MaxLength
+	}
+	for d := minDistance; d <= lz77MaxDistance && d <= pos; d++ {
+		n := 0
+		for n < maxLength && data[pos+n] == data[pos+n-d] {
+			n++
+		}
+		if n > length {
+			length, distance = n, d
+			if n == maxLength {
+				break
+			}
+		}
+	}
+	return length, distance
+}
+
+// DecompressLZ77 decompresses GBA BIOS LZ77 data (type 0x10). Padding
+// after the compressed data is ignored.
+func DecompressLZ77(data []byte) ([]byte, error) {
+	if len(data) < 4 || data[0] != lz77Type {
+		return nil, fmt.Errorf("not LZ77-compressed data")
+	}
+	size := int(data[1]) | int(data[2])<<8 | int(data[3])<<16
+	out := make([]byte, 0, size)
+	pos := 4
+	for len(out) < size {
+		if pos >= len(data) {
+			return nil, fmt.Errorf("LZ77 data ends after %d of %d bytes", len(out), size)
+		}
+		flags := data[pos]
+		pos++
+		for bit := 0x80; bit != 0 && len(out) < size; bit >>= 1 {
+			if flags&byte(bit) == 0 {
+				if pos >= len(data) {
+					return nil, fmt.Errorf("LZ77 data ends after %d of %d bytes", len(out), size)
+				}
+				out = append(out, data[pos])
+				pos++
+				continue
+			}
+			if pos+1 >= len(data) {
+				return nil, fmt.Errorf("LZ77 data ends after %d of %d bytes", len(out), size)
+			}
+			length := int(data[pos]>>4) + lz77MinLength
+			distance := (int(data[pos]&0xF)<<8 | int(data[pos+1])) + 1
+			pos += 2
+			if distance > len(out) {
+				return nil, fmt.Errorf("LZ77 copy at byte %d reaches %d bytes back, before the start of the data", len(out), distance)
+			}
+			for i := 0; i < length && len(out) < size; i++ {
+				out = append(out, out[len(out)-distance])
+			}
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,96 @@
+package gba
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestCompressLZ77(t *testing.T) {
+	got, err := CompressLZ77([]byte("AAAAAAAA"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x10, 0x08, 0x00, 0x00, 0x40, 'A', 0x40, 0x00}
+	if !bytes.Equal(got, want) {
+		t.Errorf("CompressLZ77 = % x, want % x", got, want)
+	}
+}
+
+func TestLZ77RoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	random := make([]byte, 5000)
+	rng.Read(random)
+	tiles := make([]byte, 2048)
+	for i := range tiles {
+		tiles[i] = byte(rng.Intn(3) * 0x11)
+	}
+	inputs := map[string][]byte{
+		"empty":  {},
+		"single": {7},
+		"zeros":  make([]byte, 1000),
+		"random": random,
+		"tiles":  tiles,
+	}
+	for name, data := range inputs {
+		for _, vramSafe := range []bool{false, true} {
+			compress := CompressLZ77
+			if vramSafe {
+				compress = CompressLZ77VRAMSafe
+			}
+			compressed, err := compress(data)
+			if err != nil {
+				t.Fatalf("%s: %s", name, err)
+			}
+			if len(compressed)%4 != 0 {
+				t.Errorf("%s: compressed size %d is not a multiple of 4", name, len(compressed))
+			}
+			if vramSafe && hasDistanceOneCopy(compressed) {
+				t.Errorf("%s: VRAM-safe data copies from the previous byte", name)
+			}
+			got, err := DecompressLZ77(compressed)
+			if err != nil {
+				t.Fatalf("%s: %s", name, err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Errorf("%s (VRAM-safe %t): round trip changed the data", name, vramSafe)
+			}
+		}
+	}
+}
+
+func TestDecompressLZ77Errors(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"wrong type":      {0x11, 0x01, 0x00, 0x00, 0x00, 0x00},
+		"truncated":       {0x10, 0x08, 0x00, 0x00, 0x00, 'A'},
+		"copy before end": {0x10, 0x08, 0x00, 0x00, 0x80, 0x40, 0x00},
+	} {
+		if _, err := DecompressLZ77(data); err == nil {
+			t.Errorf("%s: got no error", name)
+		}
+	}
+}
+
+// hasDistanceOneCopy reports whether compressed LZ77 data copies from the
+// byte just before the current one.
+func hasDistanceOneCopy(data []byte) bool {
+	size := int(data[1]) | int(data[2])<<8 | int(data[3])<<16
+	pos, written := 4, 0
+	for written < size {
+		flags := data[pos]
+		pos++
+		for bit := 0x80; bit != 0 && written < size; bit >>= 1 {
+			if flags&byte(bit) == 0 {
+				pos++
+				written++
+				continue
+			}
+			if data[pos]&0xF == 0 && data[pos+1] == 0 {
+				return true
+			}
+			written += int(data[pos]>>4) + 3
+			pos += 2
+		}
+	}
+	return false
+}
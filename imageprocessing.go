@@ -0,0 +1,214 @@
+package contestpaintingeffects
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+	"github.com/huderlem/contest-painting-effects/effect"
+	"github.com/huderlem/contest-painting-effects/paletteq"
+	"github.com/huderlem/contest-painting-effects/trace"
+)
+
+// ImageEffect identifies a sequence of image effects. The values match the
+// game's IMAGE_EFFECT_* constants.
+type ImageEffect int
+
+// The image effects, in the same order as the game's IMAGE_EFFECT_* constants.
+const (
+	ImageEffectNone ImageEffect = iota
+	ImageEffectPointillism
+	ImageEffectBlur
+	ImageEffectOutlineColored
+	ImageEffectInvertBlackWhite
+	ImageEffectThickBlackWhite
+	ImageEffectShimmer
+	ImageEffectOutline
+	ImageEffectInvert
+	ImageEffectBlurRight
+	ImageEffectBlurDown
+	ImageEffectGrayscaleLight
+	ImageEffectCharcoal
+)
+
+// QuantizeEffect identifies a palette quantization method. The values match
+// the game's QUANTIZE_EFFECT_* constants.
+type QuantizeEffect int
+
+// The quantization methods, in the same order as the game's QUANTIZE_EFFECT_* constants.
+const (
+	QuantizeEffectStandard QuantizeEffect = iota
+	QuantizeEffectStandardLimitedColors
+	QuantizeEffectPrimaryColors
+	QuantizeEffectGrayscale
+	QuantizeEffectGrayscaleSmall
+	QuantizeEffectBlackWhite
+)
+
+// ImageProcessingContext holds the state used to apply image effects and
+// palette quantization to a canvas. It mirrors the game's
+// struct ImageProcessingContext.
+type ImageProcessingContext struct {
+	Canvas         canvas.Canvas
+	Effect         ImageEffect
+	QuantizeEffect QuantizeEffect
+	// Personality is the lower 8 bits of the mon's personality value.
+	// It is only used by ImageEffectOutlineColored.
+	Personality uint8
+	// Region is the area of the canvas to process, like the game's column
+	// and row start and end. The zero value processes the whole canvas.
+	Region image.Rectangle
+	// Trace, if set, records the canvas before the effects, after each
+	// effect, and after quantization.
+	Trace *trace.Trace
+}
+
+func (context *ImageProcessingContext) region() []image.Rectangle {
+	if context.Region.Empty() {
+		return nil
+	}
+	return []image.Rectangle{context.Region}
+}
+
+// Validate checks that the context's image effect and quantize effect can
+// be applied to its canvas. It returns canvas.ErrEmptyCanvas or a
+// *canvas.ChannelError for invalid canvases, and an
+// *effect.RegionSizeError if the region is too small for the image effect.
+func (context *ImageProcessingContext) Validate() error {
+	if err := context.Canvas.Validate(); err != nil {
+		return err
+	}
+	switch context.Effect {
+	case ImageEffectOutlineColored, ImageEffectInvertBlackWhite, ImageEffectOutline, ImageEffectCharcoal:
+		if err := effect.ValidateOutline(context.Canvas, context.region()...); err != nil {
+			return err
+		}
+	case ImageEffectPointillism:
+		if err := effect.ValidatePointillism(context.Canvas, context.region()...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyImageProcessingEffects applies the context's image effect to its
+// canvas. The context is checked with Validate first.
+func ApplyImageProcessingEffects(context *ImageProcessingContext) error {
+	if err := context.Validate(); err != nil {
+		return err
+	}
+	steps, err := context.effectSteps()
+	if err != nil {
+		return err
+	}
+	if context.Trace != nil {
+		context.Trace.Record("Original", context.Canvas)
+	}
+	for _, step := range steps {
+		step.apply()
+		if context.Trace != nil {
+			context.Trace.Record(step.name, context.Canvas)
+		}
+	}
+	return nil
+}
+
+// effectStep is one of the effects applied for an image effect.
+type effectStep struct {
+	name  string
+	apply func()
+}
+
+// effectSteps returns the effects applied for the context's image effect,
+// in order.
+func (context *ImageProcessingContext) effectSteps() ([]effectStep, error) {
+	c := context.Canvas
+	r := context.region()
+	var (
+		blackOutline = effectStep{"Black outline", func() { effect.ApplyBlackOutline(c, r...) }}
+		blackWhite   = effectStep{"Black and white", func() { effect.ApplyBlackAndWhite(c, r...) }}
+		blur         = effectStep{"Blur", func() { effect.ApplyBlur(c, r...) }}
+		blurRight    = effectStep{"Blur right", func() { effect.ApplyBlurRight(c, r...) }}
+		blurDown     = effectStep{"Blur down", func() { effect.ApplyBlurDown(c, r...) }}
+		invert       = effectStep{"Invert", func() { effect.ApplyInvert(c, r...) }}
+	)
+	switch context.Effect {
+	case ImageEffectNone:
+		return nil, nil
+	case ImageEffectPointillism:
+		return []effectStep{{"Pointillism", func() { effect.ApplyPointillism(c, r...) }}}, nil
+	case ImageEffectBlur:
+		return []effectStep{blur}, nil
+	case ImageEffectOutlineColored:
+		return []effectStep{
+			blackOutline,
+			{"Personality color", func() { effect.ApplyPersonalityColor(c, context.Personality, r...) }},
+		}, nil
+	case ImageEffectInvertBlackWhite:
+		// The game's switch statement is missing a break here, so the
+		// black and white effect is applied twice.
+		return []effectStep{blackOutline, invert, blackWhite, blackWhite}, nil
+	case ImageEffectThickBlackWhite:
+		return []effectStep{blackWhite}, nil
+	case ImageEffectShimmer:
+		return []effectStep{{"Shimmer", func() { effect.ApplyShimmer(c, r...) }}}, nil
+	case ImageEffectOutline:
+		return []effectStep{blackOutline}, nil
+	case ImageEffectInvert:
+		return []effectStep{invert}, nil
+	case ImageEffectBlurRight:
+		return []effectStep{blurRight}, nil
+	case ImageEffectBlurDown:
+		return []effectStep{blurDown}, nil
+	case ImageEffectGrayscaleLight:
+		return []effectStep{
+			{"Grayscale", func() { effect.ApplyGrayscale(c, r...) }},
+			{"Red channel grayscale", func() { effect.ApplyRedChannelGrayscale(c, 3, r...) }},
+		}, nil
+	case ImageEffectCharcoal:
+		return []effectStep{
+			blackOutline,
+			blurRight,
+			blurDown,
+			blackWhite,
+			blur,
+			blur,
+			{"Red channel grayscale", func() { effect.ApplyRedChannelGrayscale(c, 2, r...) }},
+			{"Red channel highlight", func() { effect.ApplyRedChannelGrayscaleHighlight(c, 4, r...) }},
+		}, nil
+	}
+	return nil, fmt.Errorf("invalid image effect %d", int(context.Effect))
+}
+
+// ApplyImageProcessingQuantization quantizes the context's canvas with its
+// quantize effect, and returns the resulting palette. The canvas is checked
+// with Validate first.
+func ApplyImageProcessingQuantization(context *ImageProcessingContext) ([]canvas.RGB555, error) {
+	if err := context.Canvas.Validate(); err != nil {
+		return nil, err
+	}
+	c := context.Canvas
+	r := context.region()
+	var palette []canvas.RGB555
+	var name string
+	switch context.QuantizeEffect {
+	case QuantizeEffectStandard:
+		palette, name = paletteq.ApplyStandardQuantization(c, 256, r...), "Standard quantization"
+	case QuantizeEffectStandardLimitedColors:
+		palette, name = paletteq.ApplyStandardQuantization(c, 224, r...), "Standard quantization (224)"
+	case QuantizeEffectPrimaryColors:
+		palette, name = paletteq.ApplyPrimaryColorsQuantization(c, r...), "Primary colors quantization"
+	case QuantizeEffectGrayscale:
+		palette, name = paletteq.ApplyGrayscaleQuantization(c, r...), "Grayscale quantization"
+	case QuantizeEffectGrayscaleSmall:
+		palette, name = paletteq.ApplyGrayscaleSmallQuantization(c, r...), "Small grayscale quantization"
+	case QuantizeEffectBlackWhite:
+		palette, name = paletteq.ApplyBlackAndWhiteQuantization(c, r...), "Black and white quantization"
+	default:
+		return nil, fmt.Errorf("invalid quantize effect %d", int(context.QuantizeEffect))
+	}
+	if context.Trace != nil {
+		context.Trace.RecordQuantization(name, c, palette)
+	}
+	return palette, nil
+}
@@ -0,0 +1,61 @@
+// Reference copy of the image processing routines from pokeemerald's
+// include/image_processing_effects.h, trimmed down to compile on its own.
+#ifndef GUARD_IMAGE_PROCESSING_EFFECTS_H
+#define GUARD_IMAGE_PROCESSING_EFFECTS_H
+
+#include <stdint.h>
+
+typedef uint8_t u8;
+typedef uint16_t u16;
+typedef uint32_t u32;
+typedef int32_t s32;
+typedef u8 bool8;
+
+#define TRUE 1
+#define FALSE 0
+
+enum {
+    IMAGE_EFFECT_NONE,
+    IMAGE_EFFECT_POINTILLISM,
+    IMAGE_EFFECT_BLUR,
+    IMAGE_EFFECT_OUTLINE_COLORED,
+    IMAGE_EFFECT_INVERT_BLACK_WHITE,
+    IMAGE_EFFECT_THICK_BLACK_WHITE,
+    IMAGE_EFFECT_SHIMMER,
+    IMAGE_EFFECT_OUTLINE,
+    IMAGE_EFFECT_INVERT,
+    IMAGE_EFFECT_BLUR_RIGHT,
+    IMAGE_EFFECT_BLUR_DOWN,
+    IMAGE_EFFECT_GRAYSCALE_LIGHT,
+    IMAGE_EFFECT_CHARCOAL,
+};
+
+enum {
+    QUANTIZE_EFFECT_STANDARD,
+    QUANTIZE_EFFECT_STANDARD_LIMITED_COLORS,
+    QUANTIZE_EFFECT_PRIMARY_COLORS,
+    QUANTIZE_EFFECT_GRAYSCALE,
+    QUANTIZE_EFFECT_GRAYSCALE_SMALL,
+    QUANTIZE_EFFECT_BLACK_WHITE,
+};
+
+struct ImageProcessingContext
+{
+    u8 effect;
+    u16 *canvasPixels;
+    u16 *canvasPalette;
+    u16 quantizeEffect;
+    u16 paletteStart;
+    u16 columnStart;
+    u16 rowStart;
+    u16 columnEnd;
+    u16 rowEnd;
+    u16 canvasWidth;
+    u16 canvasHeight;
+    u32 personality;
+};
+
+void ApplyImageProcessingEffects(struct ImageProcessingContext *context);
+void ApplyImageProcessingQuantization(struct ImageProcessingContext *context);
+
+#endif // GUARD_IMAGE_PROCESSING_EFFECTS_H
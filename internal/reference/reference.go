@@ -0,0 +1,88 @@
+//go:build reference
+// +build reference
+
+// Package reference runs the original image processing routines from
+// pokeemerald, so that the Go port can be checked against them. It needs
+// cgo, and is only built with the "reference" build tag:
+//
+//	go test -tags reference ./internal/reference
+package reference
+
+/*
+#include <stdlib.h>
+#include "image_processing_effects.h"
+*/
+import "C"
+
+import "unsafe"
+
+// Width and Height are the canvas dimensions the reference routines support.
+// Some effects, like pointillism and shimmer, assume a 64x64 canvas.
+const (
+	Width  = 64
+	Height = 64
+)
+
+// Alpha is the pixel bit marking a transparent pixel.
+const Alpha = 0x8000
+
+// PaletteSize is the number of colors in the palette buffer.
+const PaletteSize = 256
+
+// ApplyEffect applies the image effect to the RGB555 pixels, in place.
+func ApplyEffect(pixels []uint16, effect int, personality uint8) {
+	checkSize(pixels)
+	canvasPixels := newBuffer(pixels)
+	defer C.free(unsafe.Pointer(canvasPixels))
+	context := newContext(canvasPixels)
+	context.effect = C.u8(effect)
+	context.personality = C.u32(personality)
+	C.ApplyImageProcessingEffects(&context)
+	copy(pixels, bufferSlice(canvasPixels, len(pixels)))
+}
+
+// ApplyQuantization quantizes the RGB555 pixels, and replaces each one with
+// its palette index. It returns the palette.
+func ApplyQuantization(pixels []uint16, quantizeEffect int) []uint16 {
+	checkSize(pixels)
+	canvasPixels := newBuffer(pixels)
+	defer C.free(unsafe.Pointer(canvasPixels))
+	canvasPalette := newBuffer(make([]uint16, PaletteSize))
+	defer C.free(unsafe.Pointer(canvasPalette))
+	context := newContext(canvasPixels)
+	context.canvasPalette = canvasPalette
+	context.quantizeEffect = C.u16(quantizeEffect)
+	C.ApplyImageProcessingQuantization(&context)
+	copy(pixels, bufferSlice(canvasPixels, len(pixels)))
+	palette := make([]uint16, PaletteSize)
+	copy(palette, bufferSlice(canvasPalette, PaletteSize))
+	return palette
+}
+
+func newContext(canvasPixels *C.u16) C.struct_ImageProcessingContext {
+	return C.struct_ImageProcessingContext{
+		canvasPixels: canvasPixels,
+		columnEnd:    Width,
+		rowEnd:       Height,
+		canvasWidth:  Width,
+		canvasHeight: Height,
+	}
+}
+
+// newBuffer copies the values into C memory, since the C routines keep
+// pointers to the canvas in global variables.
+func newBuffer(values []uint16) *C.u16 {
+	buffer := (*C.u16)(C.malloc(C.size_t(len(values) * 2)))
+	copy(bufferSlice(buffer, len(values)), values)
+	return buffer
+}
+
+func bufferSlice(buffer *C.u16, length int) []uint16 {
+	return (*[1 << 20]uint16)(unsafe.Pointer(buffer))[:length:length]
+}
+
+func checkSize(pixels []uint16) {
+	if len(pixels) != Width*Height {
+		panic("reference: canvas must be 64x64")
+	}
+}
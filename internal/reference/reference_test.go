@@ -0,0 +1,147 @@
+//go:build reference
+// +build reference
+
+package reference
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	contestpaintingeffects "github.com/huderlem/contest-painting-effects"
+	"github.com/huderlem/contest-painting-effects/canvas"
+)
+
+var (
+	seed       = flag.Int64("seed", 1, "random seed for the generated canvases")
+	iterations = flag.Int("iterations", 100, "number of canvases generated for each effect")
+)
+
+const (
+	numImageEffects    = int(contestpaintingeffects.ImageEffectCharcoal) + 1
+	numQuantizeEffects = int(contestpaintingeffects.QuantizeEffectBlackWhite) + 1
+)
+
+func TestImageEffects(t *testing.T) {
+	rng := rand.New(rand.NewSource(*seed))
+	for effect := 0; effect < numImageEffects; effect++ {
+		for i := 0; i < *iterations; i++ {
+			pixels := randomCanvas(rng)
+			personality := uint8(rng.Intn(256))
+			c := toCanvas(pixels)
+			context := contestpaintingeffects.ImageProcessingContext{
+				Canvas:      c,
+				Effect:      contestpaintingeffects.ImageEffect(effect),
+				Personality: personality,
+			}
+			if err := contestpaintingeffects.ApplyImageProcessingEffects(&context); err != nil {
+				t.Fatal(err)
+			}
+			ApplyEffect(pixels, effect, personality)
+			if x, y, ok := comparePixels(c, pixels); !ok {
+				t.Errorf("image effect %d, canvas %d, personality %d: pixel (%d, %d) is %#04x, want %#04x",
+					effect, i, personality, x, y, toRGB555(c.Pixel(x, y)), pixels[y*Width+x])
+				break
+			}
+		}
+	}
+}
+
+func TestQuantizeEffects(t *testing.T) {
+	rng := rand.New(rand.NewSource(*seed))
+	for quantizeEffect := 0; quantizeEffect < numQuantizeEffects; quantizeEffect++ {
+		for i := 0; i < *iterations; i++ {
+			pixels := randomCanvas(rng)
+			c := toCanvas(pixels)
+			context := contestpaintingeffects.ImageProcessingContext{
+				Canvas:         c,
+				QuantizeEffect: contestpaintingeffects.QuantizeEffect(quantizeEffect),
+			}
+			palette, err := contestpaintingeffects.ApplyImageProcessingQuantization(&context)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantPalette := ApplyQuantization(pixels, quantizeEffect)
+			name := fmt.Sprintf("quantize effect %d, canvas %d", quantizeEffect, i)
+			for index, paletteColor := range palette {
+				if got := toRGB555(paletteColor) &^ Alpha; got != wantPalette[index] {
+					t.Errorf("%s: palette color %d is %#04x, want %#04x", name, index, got, wantPalette[index])
+				}
+			}
+			for y := 0; y < Height; y++ {
+				for x := 0; x < Width; x++ {
+					if got, want := c.AtColorIndex(x, y), int(pixels[y*Width+x]); got != want {
+						t.Fatalf("%s: pixel (%d, %d) has color index %d, want %d", name, x, y, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+// randomCanvas returns a 64x64 canvas of RGB555 pixels. Transparent pixels
+// only have the alpha bit set, like the game's contest mon pictures. Half of
+// the canvases are noise, and the other half are made of flat rectangles,
+// so that effects comparing neighboring pixels see both similar and
+// different colors.
+func randomCanvas(rng *rand.Rand) []uint16 {
+	pixels := make([]uint16, Width*Height)
+	randomColor := func() uint16 {
+		if rng.Intn(4) == 0 {
+			return Alpha
+		}
+		return uint16(rng.Intn(Alpha))
+	}
+	if rng.Intn(2) == 0 {
+		for i := range pixels {
+			pixels[i] = randomColor()
+		}
+		return pixels
+	}
+	for i := 0; i < 40; i++ {
+		x0, y0 := rng.Intn(Width), rng.Intn(Height)
+		x1, y1 := x0+1+rng.Intn(24), y0+1+rng.Intn(24)
+		pixel := randomColor()
+		for y := y0; y < y1 && y < Height; y++ {
+			for x := x0; x < x1 && x < Width; x++ {
+				pixels[y*Width+x] = pixel
+			}
+		}
+	}
+	return pixels
+}
+
+func toCanvas(pixels []uint16) canvas.Canvas {
+	c := canvas.New(Width, Height)
+	for y := 0; y < Height; y++ {
+		for x := 0; x < Width; x++ {
+			pixel := pixels[y*Width+x]
+			var a uint8 = 255
+			if pixel&Alpha != 0 {
+				a = 0
+			}
+			c.SetPixel(x, y, canvas.RGB555{R: uint8(pixel & 0x1F), G: uint8(pixel >> 5 & 0x1F), B: uint8(pixel >> 10 & 0x1F), A: a})
+		}
+	}
+	return c
+}
+
+func toRGB555(pixel canvas.RGB555) uint16 {
+	value := pixel.BGR555()
+	if pixel.A != 255 {
+		value |= Alpha
+	}
+	return value
+}
+
+func comparePixels(c canvas.Canvas, pixels []uint16) (int, int, bool) {
+	for y := 0; y < Height; y++ {
+		for x := 0; x < Width; x++ {
+			if toRGB555(c.Pixel(x, y)) != pixels[y*Width+x] {
+				return x, y, false
+			}
+		}
+	}
+	return 0, 0, true
+}
@@ -0,0 +1,98 @@
+package paletteq
+
+import (
+	"errors"
+	"fmt"
+	"image"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+)
+
+// ColorMetric selects how ApplyFixedPaletteQuantization measures the
+// distance between two colors.
+type ColorMetric int
+
+// The color metrics.
+const (
+	// MetricEuclidean is the squared distance between the colors' channels.
+	MetricEuclidean ColorMetric = iota
+	// MetricWeighted weights the channels by how sensitive the eye is to
+	// them (2 for red, 4 for green, 3 for blue), which usually picks
+	// closer-looking colors than MetricEuclidean.
+	MetricWeighted
+)
+
+// ErrNoPaletteColors is returned by ValidateFixedPalette for palettes
+// without any opaque colors after index 0.
+var ErrNoPaletteColors = errors.New("palette has no opaque colors after index 0")
+
+// distance returns the distance between two colors.
+func (metric ColorMetric) distance(a, b canvas.RGB555) int {
+	dr := int(a.R) - int(b.R)
+	dg := int(a.G) - int(b.G)
+	db := int(a.B) - int(b.B)
+	if metric == MetricWeighted {
+		return 2*dr*dr + 4*dg*dg + 3*db*db
+	}
+	return dr*dr + dg*dg + db*db
+}
+
+// ValidateFixedPalette checks that the palette can be used with
+// ApplyFixedPaletteQuantization. Its color indexes must fit in a canvas,
+// and it needs at least one opaque color after index 0.
+func ValidateFixedPalette(palette []canvas.RGB555) error {
+	if len(palette) > canvas.MaxPaletteSize {
+		return fmt.Errorf("palette has %d colors, but canvases can only index %d", len(palette), canvas.MaxPaletteSize)
+	}
+	for i := 1; i < len(palette); i++ {
+		if palette[i].A == 255 {
+			return nil
+		}
+	}
+	return ErrNoPaletteColors
+}
+
+// ApplyFixedPaletteQuantization assigns canvas pixels to the nearest color
+// of a given palette, such as one loaded with the palfile package, instead
+// of generating a palette. Like the game's palettes, index 0 is reserved
+// for transparent pixels, so opaque pixels are only matched with the opaque
+// colors after index 0. When several colors are equally near, the lowest
+// index is used. It returns the given palette. If the palette is not valid
+// according to ValidateFixedPalette, it returns the error from
+// ValidateFixedPalette and leaves the canvas unchanged.
+func ApplyFixedPaletteQuantization(c canvas.Canvas, palette []canvas.RGB555, metric ColorMetric, region ...image.Rectangle) ([]canvas.RGB555, error) {
+	if err := ValidateFixedPalette(palette); err != nil {
+		return nil, err
+	}
+	r := c.Region(region...)
+	nearest := make(map[canvas.RGB555]int)
+	for x := r.Min.X; x < r.Max.X; x++ {
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			pixel := c.Pixel(x, y)
+			if pixel.A != 255 {
+				setColorIndex(c, x, y, 0)
+				continue
+			}
+			index, ok := nearest[pixel]
+			if !ok {
+				index = nearestIndex(pixel, palette, metric)
+				nearest[pixel] = index
+			}
+			setColorIndex(c, x, y, index)
+		}
+	}
+	return palette, nil
+}
+
+func nearestIndex(pixel canvas.RGB555, palette []canvas.RGB555, metric ColorMetric) int {
+	best, bestDistance := 0, -1
+	for i := 1; i < len(palette); i++ {
+		if palette[i].A != 255 {
+			continue
+		}
+		if d := metric.distance(pixel, palette[i]); bestDistance < 0 || d < bestDistance {
+			best, bestDistance = i, d
+		}
+	}
+	return best
+}
@@ -1,26 +1,63 @@
 package paletteq
 
 import (
-	"image/color"
+	"fmt"
+	"image"
 
 	"github.com/huderlem/contest-painting-effects/pixelq"
 
 	"github.com/huderlem/contest-painting-effects/canvas"
 )
 
+// MaxColorsError is returned by ValidateMaxColors for a palette size that
+// ApplyStandardQuantization cannot produce.
+type MaxColorsError int
+
+func (e MaxColorsError) Error() string {
+	return fmt.Sprintf("max colors %d is out of range 2-%d", int(e), canvas.MaxPaletteSize)
+}
+
+// ValidateMaxColors checks that maxColors can be used with
+// ApplyStandardQuantization. The palette needs room for the transparent
+// color and the gray fallback color, and its indexes must fit in a canvas.
+func ValidateMaxColors(maxColors int) error {
+	if maxColors < 2 || maxColors > canvas.MaxPaletteSize {
+		return MaxColorsError(maxColors)
+	}
+	return nil
+}
+
 // ApplyStandardQuantization generates a quantized palette for the Canvas pixels, and
 // assigns canvas pixels to each color in the quantized palette.
-func ApplyStandardQuantization(c canvas.Canvas, maxColors int) []color.RGBA {
-	palette := make([]color.RGBA, maxColors)
+// maxColors must be valid according to ValidateMaxColors. Otherwise, it
+// panics with a MaxColorsError. ApplyStandardQuantizationChecked returns the
+// error instead.
+func ApplyStandardQuantization(c canvas.Canvas, maxColors int, region ...image.Rectangle) []canvas.RGB555 {
+	palette, err := ApplyStandardQuantizationChecked(c, maxColors, region...)
+	if err != nil {
+		panic(err)
+	}
+	return palette
+}
+
+// ApplyStandardQuantizationChecked is like ApplyStandardQuantization, but
+// returns a MaxColorsError, and leaves the canvas unchanged, if maxColors is
+// not valid according to ValidateMaxColors.
+func ApplyStandardQuantizationChecked(c canvas.Canvas, maxColors int, region ...image.Rectangle) ([]canvas.RGB555, error) {
+	if err := ValidateMaxColors(maxColors); err != nil {
+		return nil, err
+	}
+	r := c.Region(region...)
+	palette := make([]canvas.RGB555, maxColors)
 	for i := 0; i < maxColors-1; i++ {
-		palette[i] = color.RGBA{0, 0, 0, 0}
+		palette[i] = canvas.RGB555{R: 0, G: 0, B: 0, A: 0}
 	}
-	palette[maxColors-1] = color.RGBA{15, 15, 15, 255}
-	for y := 0; y < c.Height(); y++ {
-		for x := 0; x < c.Width(); x++ {
-			pixel := c.At(x, y)
+	palette[maxColors-1] = canvas.RGB555{R: 15, G: 15, B: 15, A: 255}
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			pixel := c.Pixel(x, y)
 			if pixel.A != 255 {
-				c.SetColorIndex(x, y, 0)
+				setColorIndex(c, x, y, 0)
 			} else {
 				quantizedPixel := quantizePixelStandard(pixel)
 				success := false
@@ -32,14 +69,14 @@ func ApplyStandardQuantization(c canvas.Canvas, maxColors int) []color.RGBA {
 						// This if block seems pointless because the below while loop handles
 						// this same logic.
 						palette[curIndex] = quantizedPixel
-						c.SetColorIndex(x, y, curIndex)
+						setColorIndex(c, x, y, curIndex)
 						success = true
 						break
 					} else if curColor.R == quantizedPixel.R && curColor.G == quantizedPixel.G &&
 						curColor.B == quantizedPixel.B && curColor.A == quantizedPixel.A {
 						// The quantized color matches this existing color in the
 						// palette, so we use this existing color for the pixel.
-						c.SetColorIndex(x, y, curIndex)
+						setColorIndex(c, x, y, curIndex)
 						success = true
 						break
 					}
@@ -48,15 +85,24 @@ func ApplyStandardQuantization(c canvas.Canvas, maxColors int) []color.RGBA {
 					// The entire palette's colors are already in use, which means
 					// the base image has too many colors to handle. This error is handled
 					// by marking such pixels as gray color.
-					c.SetColorIndex(x, y, maxColors-1)
+					setColorIndex(c, x, y, maxColors-1)
 				}
 			}
 		}
 	}
-	return palette
+	return palette, nil
 }
 
-func quantizePixelStandard(pixel color.RGBA) color.RGBA {
+// setColorIndex sets the color index of a pixel. The quantizers only assign
+// indexes of palettes that fit in a canvas, so an index that SetColorIndex
+// rejects is a bug, and panics.
+func setColorIndex(c canvas.Canvas, x, y, index int) {
+	if err := c.SetColorIndex(x, y, index); err != nil {
+		panic(err)
+	}
+}
+
+func quantizePixelStandard(pixel canvas.RGB555) canvas.RGB555 {
 	r, g, b := pixel.R, pixel.G, pixel.B
 
 	// Quantize color channels to muliples of 4, rounding up.
@@ -89,37 +135,38 @@ func quantizePixelStandard(pixel color.RGBA) color.RGBA {
 	if b > 30 {
 		b = 30
 	}
-	return color.RGBA{r, g, b, pixel.A}
+	return canvas.RGB555{R: r, G: g, B: b, A: pixel.A}
 }
 
 // ApplyPrimaryColorsQuantization generates a quantized palette for the Canvas pixels, which
 // is basd on a preset list of bright primary colors.
-func ApplyPrimaryColorsQuantization(c canvas.Canvas) []color.RGBA {
-	palette := make([]color.RGBA, 16)
-	palette[0] = color.RGBA{0, 0, 0, 0}
-	palette[1] = color.RGBA{R: 6, G: 6, B: 6, A: 255}
-	palette[2] = color.RGBA{R: 29, G: 29, B: 29, A: 255}
-	palette[3] = color.RGBA{R: 11, G: 11, B: 11, A: 255}
-	palette[4] = color.RGBA{R: 29, G: 6, B: 6, A: 255}
-	palette[5] = color.RGBA{R: 6, G: 29, B: 6, A: 255}
-	palette[6] = color.RGBA{R: 6, G: 6, B: 29, A: 255}
-	palette[7] = color.RGBA{R: 29, G: 29, B: 6, A: 255}
-	palette[8] = color.RGBA{R: 29, G: 6, B: 29, A: 255}
-	palette[9] = color.RGBA{R: 6, G: 29, B: 29, A: 255}
-	palette[10] = color.RGBA{R: 29, G: 11, B: 6, A: 255}
-	palette[11] = color.RGBA{R: 11, G: 29, B: 6, A: 255}
-	palette[12] = color.RGBA{R: 6, G: 11, B: 29, A: 255}
-	palette[13] = color.RGBA{R: 29, G: 6, B: 11, A: 255}
-	palette[14] = color.RGBA{R: 6, G: 29, B: 11, A: 255}
-	palette[15] = color.RGBA{R: 11, G: 6, B: 29, A: 255}
-
-	for x := 0; x < c.Width(); x++ {
-		for y := 0; y < c.Height(); y++ {
-			pixel := c.At(x, y)
+func ApplyPrimaryColorsQuantization(c canvas.Canvas, region ...image.Rectangle) []canvas.RGB555 {
+	r := c.Region(region...)
+	palette := make([]canvas.RGB555, 16)
+	palette[0] = canvas.RGB555{R: 0, G: 0, B: 0, A: 0}
+	palette[1] = canvas.RGB555{R: 6, G: 6, B: 6, A: 255}
+	palette[2] = canvas.RGB555{R: 29, G: 29, B: 29, A: 255}
+	palette[3] = canvas.RGB555{R: 11, G: 11, B: 11, A: 255}
+	palette[4] = canvas.RGB555{R: 29, G: 6, B: 6, A: 255}
+	palette[5] = canvas.RGB555{R: 6, G: 29, B: 6, A: 255}
+	palette[6] = canvas.RGB555{R: 6, G: 6, B: 29, A: 255}
+	palette[7] = canvas.RGB555{R: 29, G: 29, B: 6, A: 255}
+	palette[8] = canvas.RGB555{R: 29, G: 6, B: 29, A: 255}
+	palette[9] = canvas.RGB555{R: 6, G: 29, B: 29, A: 255}
+	palette[10] = canvas.RGB555{R: 29, G: 11, B: 6, A: 255}
+	palette[11] = canvas.RGB555{R: 11, G: 29, B: 6, A: 255}
+	palette[12] = canvas.RGB555{R: 6, G: 11, B: 29, A: 255}
+	palette[13] = canvas.RGB555{R: 29, G: 6, B: 11, A: 255}
+	palette[14] = canvas.RGB555{R: 6, G: 29, B: 11, A: 255}
+	palette[15] = canvas.RGB555{R: 11, G: 6, B: 29, A: 255}
+
+	for x := r.Min.X; x < r.Max.X; x++ {
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			pixel := c.Pixel(x, y)
 			if pixel.A != 255 {
-				c.SetColorIndex(x, y, 0)
+				setColorIndex(c, x, y, 0)
 			} else {
-				c.SetColorIndex(x, y, quantizePixelPrimaryColorsIndex(pixel))
+				setColorIndex(c, x, y, quantizePixelPrimaryColorsIndex(pixel))
 			}
 		}
 	}
@@ -127,7 +174,7 @@ func ApplyPrimaryColorsQuantization(c canvas.Canvas) []color.RGBA {
 	return palette
 }
 
-func quantizePixelPrimaryColorsIndex(pixel color.RGBA) int {
+func quantizePixelPrimaryColorsIndex(pixel canvas.RGB555) int {
 	if pixel.R < 12 && pixel.G < 11 && pixel.B < 11 {
 		return 1
 	}
@@ -210,21 +257,23 @@ func quantizePixelPrimaryColorsIndex(pixel color.RGBA) int {
 }
 
 // ApplyGrayscaleQuantization generates a quantized palette for grayscale
-// (32) colors.
-func ApplyGrayscaleQuantization(c canvas.Canvas) []color.RGBA {
-	palette := make([]color.RGBA, 33)
-	palette[0] = color.RGBA{0, 0, 0, 0}
+// (32) colors. Pixels whose channels are above 31, which a canvas in
+// canvas.ChannelsUnchecked mode keeps, are quantized to the lightest gray.
+func ApplyGrayscaleQuantization(c canvas.Canvas, region ...image.Rectangle) []canvas.RGB555 {
+	r := c.Region(region...)
+	palette := make([]canvas.RGB555, 33)
+	palette[0] = canvas.RGB555{R: 0, G: 0, B: 0, A: 0}
 	for i := uint8(0); i < 32; i++ {
-		palette[i+1] = color.RGBA{i, i, i, 255}
+		palette[i+1] = canvas.RGB555{R: i, G: i, B: i, A: 255}
 	}
 
-	for x := 0; x < c.Width(); x++ {
-		for y := 0; y < c.Height(); y++ {
-			pixel := c.At(x, y)
+	for x := r.Min.X; x < r.Max.X; x++ {
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			pixel := c.Pixel(x, y)
 			if pixel.A != 255 {
-				c.SetColorIndex(x, y, 0)
+				setColorIndex(c, x, y, 0)
 			} else {
-				c.SetColorIndex(x, y, quantizePixelGrayscale(pixel))
+				setColorIndex(c, x, y, quantizePixelGrayscale(pixel))
 			}
 		}
 	}
@@ -232,29 +281,33 @@ func ApplyGrayscaleQuantization(c canvas.Canvas) []color.RGBA {
 	return palette
 }
 
-func quantizePixelGrayscale(pixel color.RGBA) int {
+func quantizePixelGrayscale(pixel canvas.RGB555) int {
 	avg := (int(pixel.R) + int(pixel.G) + int(pixel.B)) / 3
+	if avg > 31 {
+		avg = 31
+	}
 	return avg + 1
 }
 
 // ApplyGrayscaleSmallQuantization generates a quantized palette for grayscale
 // (16) colors.
-func ApplyGrayscaleSmallQuantization(c canvas.Canvas) []color.RGBA {
-	palette := make([]color.RGBA, 16)
-	palette[0] = color.RGBA{0, 0, 0, 0}
-	palette[1] = color.RGBA{0, 0, 0, 255}
+func ApplyGrayscaleSmallQuantization(c canvas.Canvas, region ...image.Rectangle) []canvas.RGB555 {
+	r := c.Region(region...)
+	palette := make([]canvas.RGB555, 16)
+	palette[0] = canvas.RGB555{R: 0, G: 0, B: 0, A: 0}
+	palette[1] = canvas.RGB555{R: 0, G: 0, B: 0, A: 255}
 	for i := uint8(0); i < 14; i++ {
 		grayValue := 2 * (i + 2)
-		palette[i+2] = color.RGBA{grayValue, grayValue, grayValue, 255}
+		palette[i+2] = canvas.RGB555{R: grayValue, G: grayValue, B: grayValue, A: 255}
 	}
 
-	for x := 0; x < c.Width(); x++ {
-		for y := 0; y < c.Height(); y++ {
-			pixel := c.At(x, y)
+	for x := r.Min.X; x < r.Max.X; x++ {
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			pixel := c.Pixel(x, y)
 			if pixel.A != 255 {
-				c.SetColorIndex(x, y, 0)
+				setColorIndex(c, x, y, 0)
 			} else {
-				c.SetColorIndex(x, y, quantizePixelGrayscaleSmall(pixel))
+				setColorIndex(c, x, y, quantizePixelGrayscaleSmall(pixel))
 			}
 		}
 	}
@@ -262,7 +315,7 @@ func ApplyGrayscaleSmallQuantization(c canvas.Canvas) []color.RGBA {
 	return palette
 }
 
-func quantizePixelGrayscaleSmall(pixel color.RGBA) int {
+func quantizePixelGrayscaleSmall(pixel canvas.RGB555) int {
 	avg := uint8((int(pixel.R) + int(pixel.G) + int(pixel.B)) / 3)
 	avg = avg & 0x1E
 	if avg == 0 {
@@ -273,23 +326,24 @@ func quantizePixelGrayscaleSmall(pixel color.RGBA) int {
 
 // ApplyBlackAndWhiteQuantization generates a quantized palette for black
 // and white colors.
-func ApplyBlackAndWhiteQuantization(c canvas.Canvas) []color.RGBA {
-	palette := make([]color.RGBA, 3)
-	palette[0] = color.RGBA{0, 0, 0, 0}
-	palette[1] = color.RGBA{0, 0, 0, 255}
-	palette[2] = color.RGBA{31, 31, 31, 255}
-
-	for x := 0; x < c.Width(); x++ {
-		for y := 0; y < c.Height(); y++ {
-			pixel := c.At(x, y)
+func ApplyBlackAndWhiteQuantization(c canvas.Canvas, region ...image.Rectangle) []canvas.RGB555 {
+	r := c.Region(region...)
+	palette := make([]canvas.RGB555, 3)
+	palette[0] = canvas.RGB555{R: 0, G: 0, B: 0, A: 0}
+	palette[1] = canvas.RGB555{R: 0, G: 0, B: 0, A: 255}
+	palette[2] = canvas.RGB555{R: 31, G: 31, B: 31, A: 255}
+
+	for x := r.Min.X; x < r.Max.X; x++ {
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			pixel := c.Pixel(x, y)
 			if pixel.A != 255 {
-				c.SetColorIndex(x, y, 0)
+				setColorIndex(c, x, y, 0)
 			} else {
 				qp := pixelq.BlackAndWhite(pixel)
 				if qp.R == 0 && qp.G == 0 && qp.B == 0 {
-					c.SetColorIndex(x, y, 1)
+					setColorIndex(c, x, y, 1)
 				} else {
-					c.SetColorIndex(x, y, 2)
+					setColorIndex(c, x, y, 2)
 				}
 			}
 		}
@@ -0,0 +1,196 @@
+package paletteq
+
+import (
+	"image"
+	"testing"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+)
+
+func TestValidateMaxColors(t *testing.T) {
+	for _, maxColors := range []int{2, 224, 256} {
+		if err := ValidateMaxColors(maxColors); err != nil {
+			t.Errorf("ValidateMaxColors(%d) = %v, want nil", maxColors, err)
+		}
+	}
+	for _, maxColors := range []int{-1, 0, 1, 257} {
+		if err := ValidateMaxColors(maxColors); err != MaxColorsError(maxColors) {
+			t.Errorf("ValidateMaxColors(%d) = %v, want MaxColorsError(%d)", maxColors, err, maxColors)
+		}
+	}
+}
+
+// recoverError calls f, and returns the value it panics with.
+func recoverError(f func()) (recovered interface{}) {
+	defer func() {
+		recovered = recover()
+	}()
+	f()
+	return nil
+}
+
+func TestApplyStandardQuantizationMaxColors(t *testing.T) {
+	for _, maxColors := range []int{0, 1, 257} {
+		got := recoverError(func() {
+			ApplyStandardQuantization(canvas.New(4, 4), maxColors)
+		})
+		if got != MaxColorsError(maxColors) {
+			t.Errorf("ApplyStandardQuantization with %d colors panicked with %v, want MaxColorsError(%d)", maxColors, got, maxColors)
+		}
+	}
+	if got := recoverError(func() { ApplyStandardQuantization(canvas.New(4, 4), 2) }); got != nil {
+		t.Errorf("ApplyStandardQuantization with 2 colors panicked with %v", got)
+	}
+}
+
+func TestApplyStandardQuantizationChecked(t *testing.T) {
+	for _, maxColors := range []int{0, 1, 257} {
+		c := canvas.New(4, 4)
+		c.SetColorIndex(0, 0, 7)
+		palette, err := ApplyStandardQuantizationChecked(c, maxColors)
+		if err != MaxColorsError(maxColors) || palette != nil {
+			t.Errorf("ApplyStandardQuantizationChecked with %d colors = %v, %v, want nil, MaxColorsError(%d)", maxColors, palette, err, maxColors)
+		}
+		if index := c.AtColorIndex(0, 0); index != 7 {
+			t.Errorf("ApplyStandardQuantizationChecked with %d colors changed color index to %d", maxColors, index)
+		}
+	}
+	palette, err := ApplyStandardQuantizationChecked(canvas.New(4, 4), 2)
+	if err != nil || len(palette) != 2 {
+		t.Errorf("ApplyStandardQuantizationChecked with 2 colors = %v, %v, want 2 colors", palette, err)
+	}
+}
+
+func TestApplyGrayscaleQuantizationUncheckedChannels(t *testing.T) {
+	c := canvas.New(2, 1)
+	c.SetPixel(0, 0, canvas.RGB555{R: 255, G: 255, B: 255, A: 255})
+	c.SetPixel(1, 0, canvas.RGB555{R: 31, G: 31, B: 31, A: 255})
+	if got := recoverError(func() { ApplyGrayscaleQuantization(c) }); got != nil {
+		t.Fatalf("ApplyGrayscaleQuantization panicked with %v", got)
+	}
+	for x := 0; x < 2; x++ {
+		if index := c.AtColorIndex(x, 0); index != 32 {
+			t.Errorf("pixel %d has color index %d, want 32", x, index)
+		}
+	}
+}
+
+func TestApplyFixedPaletteQuantization(t *testing.T) {
+	palette := []canvas.RGB555{
+		{},
+		{R: 31, G: 0, B: 0, A: 255},
+		{R: 0, G: 31, B: 0, A: 255},
+		{R: 0, G: 0, B: 31, A: 0},
+		{R: 0, G: 0, B: 20, A: 255},
+		{R: 31, G: 31, B: 31, A: 255},
+	}
+	c := canvas.New(5, 1)
+	c.SetPixel(0, 0, canvas.RGB555{R: 25, G: 3, B: 2, A: 255})
+	c.SetPixel(1, 0, canvas.RGB555{R: 1, G: 1, B: 31, A: 255})
+	c.SetPixel(2, 0, canvas.RGB555{R: 31, G: 31, B: 31})
+	c.SetPixel(3, 0, canvas.RGB555{R: 28, G: 30, B: 27, A: 255})
+	c.SetPixel(4, 0, canvas.RGB555{R: 25, G: 3, B: 2, A: 255})
+
+	got, err := ApplyFixedPaletteQuantization(c, palette, MetricEuclidean)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(palette) {
+		t.Fatalf("returned palette has %d colors, want %d", len(got), len(palette))
+	}
+	for x, want := range []int{1, 4, 0, 5, 1} {
+		if index := c.AtColorIndex(x, 0); index != want {
+			t.Errorf("pixel %d has color index %d, want %d", x, index, want)
+		}
+	}
+}
+
+func TestColorMetric(t *testing.T) {
+	// Equally far from both colors in RGB, but the green difference is
+	// more visible, so the weighted metric prefers the color with the
+	// closer green channel.
+	palette := []canvas.RGB555{{}, {R: 10, G: 16, B: 10, A: 255}, {R: 16, G: 10, B: 10, A: 255}}
+	pixel := canvas.RGB555{R: 10, G: 10, B: 10, A: 255}
+	for _, tt := range []struct {
+		metric ColorMetric
+		want   int
+	}{{MetricEuclidean, 1}, {MetricWeighted, 2}} {
+		c := canvas.New(1, 1)
+		c.SetPixel(0, 0, pixel)
+		if _, err := ApplyFixedPaletteQuantization(c, palette, tt.metric); err != nil {
+			t.Fatal(err)
+		}
+		if got := c.AtColorIndex(0, 0); got != tt.want {
+			t.Errorf("metric %d picked index %d, want %d", tt.metric, got, tt.want)
+		}
+	}
+}
+
+func TestValidateFixedPalette(t *testing.T) {
+	if err := ValidateFixedPalette([]canvas.RGB555{{}, {R: 1, A: 255}}); err != nil {
+		t.Errorf("valid palette: got error %v", err)
+	}
+	if err := ValidateFixedPalette([]canvas.RGB555{{R: 1, A: 255}, {R: 2}}); err != ErrNoPaletteColors {
+		t.Errorf("palette without opaque colors: got %v, want ErrNoPaletteColors", err)
+	}
+	if err := ValidateFixedPalette(nil); err != ErrNoPaletteColors {
+		t.Errorf("empty palette: got %v, want ErrNoPaletteColors", err)
+	}
+	if err := ValidateFixedPalette(make([]canvas.RGB555, 257)); err == nil {
+		t.Errorf("palette with 257 colors: got no error")
+	}
+	c := canvas.New(4, 4)
+	c.SetColorIndex(0, 0, 7)
+	if palette, err := ApplyFixedPaletteQuantization(c, nil, MetricEuclidean); err != ErrNoPaletteColors || palette != nil {
+		t.Errorf("ApplyFixedPaletteQuantization with an empty palette = %v, %v, want nil, ErrNoPaletteColors", palette, err)
+	}
+	if _, err := ApplyFixedPaletteQuantization(c, make([]canvas.RGB555, 300), MetricEuclidean); err == nil {
+		t.Errorf("ApplyFixedPaletteQuantization with 300 colors: got no error")
+	}
+	if index := c.AtColorIndex(0, 0); index != 7 {
+		t.Errorf("ApplyFixedPaletteQuantization with an invalid palette changed color index to %d", index)
+	}
+}
+
+func TestQuantizersStayInRegion(t *testing.T) {
+	region := image.Rect(5, 3, 13, 11)
+	fixedPalette := []canvas.RGB555{{}, {R: 31, A: 255}, {G: 31, A: 255}, {B: 31, A: 255}}
+	quantizers := []struct {
+		name  string
+		apply func(c canvas.Canvas)
+	}{
+		{"Standard", func(c canvas.Canvas) { ApplyStandardQuantization(c, 224, region) }},
+		{"PrimaryColors", func(c canvas.Canvas) { ApplyPrimaryColorsQuantization(c, region) }},
+		{"Grayscale", func(c canvas.Canvas) { ApplyGrayscaleQuantization(c, region) }},
+		{"GrayscaleSmall", func(c canvas.Canvas) { ApplyGrayscaleSmallQuantization(c, region) }},
+		{"BlackAndWhite", func(c canvas.Canvas) { ApplyBlackAndWhiteQuantization(c, region) }},
+		{"FixedPalette", func(c canvas.Canvas) {
+			if _, err := ApplyFixedPaletteQuantization(c, fixedPalette, MetricEuclidean, region); err != nil {
+				t.Fatal(err)
+			}
+		}},
+	}
+	// Every pixel starts with color index 99, which no quantizer assigns to
+	// these pixels.
+	const unset = 99
+	for _, q := range quantizers {
+		c := canvas.New(16, 16)
+		for y := 0; y < 16; y++ {
+			for x := 0; x < 16; x++ {
+				c.SetPixel(x, y, canvas.RGB555{R: uint8(x * 2), G: uint8(y * 2), B: uint8(x + y), A: 255})
+				c.SetColorIndex(x, y, unset)
+			}
+		}
+		q.apply(c)
+		for y := 0; y < 16; y++ {
+			for x := 0; x < 16; x++ {
+				inRegion := image.Pt(x, y).In(region)
+				if index := c.AtColorIndex(x, y); inRegion && index == unset {
+					t.Errorf("%s: pixel (%d, %d) in the region was not quantized", q.name, x, y)
+				} else if !inRegion && index != unset {
+					t.Errorf("%s: pixel (%d, %d) outside the region got color index %d", q.name, x, y, index)
+				}
+			}
+		}
+	}
+}
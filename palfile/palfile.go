@@ -0,0 +1,236 @@
+// Package palfile reads and writes palettes in the file formats used by
+// pixel art tools and GBA ROM tools: JASC-PAL, GIMP GPL, Adobe ACT and raw
+// GBA BGR555 palettes.
+//
+// The 8-bit formats store colors expanded with canvas.ExpandReplicate, so
+// white is written as 255 255 255. Colors are read back with
+// canvas.ConvertGBA, which turns expanded colors into the original 5-bit
+// colors.
+//
+// None of the formats store alpha. Transparent palette entries are written
+// with their RGB value, and index 0 is read as transparent, the same way
+// the game and ToPaletted treat it.
+package palfile
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"image/color"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+	"github.com/huderlem/contest-painting-effects/gba"
+)
+
+// actColors is the number of colors stored in an ACT file.
+const actColors = 256
+
+// actNoTransparency is the ACT transparent index that means no color is
+// transparent.
+const actNoTransparency = 0xFFFF
+
+// WriteJASC writes the palette as a JASC-PAL file, the text format used by
+// Paint Shop Pro and pokeemerald's .pal files. Lines end with CRLF.
+func WriteJASC(w io.Writer, palette []canvas.RGB555) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "JASC-PAL\r\n0100\r\n%d\r\n", len(palette))
+	for _, paletteColor := range palette {
+		c := expand(paletteColor)
+		fmt.Fprintf(bw, "%d %d %d\r\n", c.R, c.G, c.B)
+	}
+	return bw.Flush()
+}
+
+// ReadJASC reads a JASC-PAL file.
+func ReadJASC(r io.Reader) ([]canvas.RGB555, error) {
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) < 3 || lines[0] != "JASC-PAL" {
+		return nil, fmt.Errorf("not a JASC-PAL file")
+	}
+	if lines[1] != "0100" {
+		return nil, fmt.Errorf("unsupported JASC-PAL version %q", lines[1])
+	}
+	count, err := strconv.Atoi(lines[2])
+	if err != nil || count < 0 {
+		return nil, fmt.Errorf("invalid JASC-PAL color count %q", lines[2])
+	}
+	lines = lines[3:]
+	if len(lines) < count {
+		return nil, fmt.Errorf("JASC-PAL file has %d colors, but its header says %d", len(lines), count)
+	}
+	palette := make([]canvas.RGB555, count)
+	for i := range palette {
+		c, err := parseRGB(lines[i])
+		if err != nil {
+			return nil, fmt.Errorf("JASC-PAL color %d: %s", i, err)
+		}
+		palette[i] = c
+	}
+	return markTransparent(palette, 0), nil
+}
+
+// WriteGPL writes the palette as a GIMP palette. Each color is named after
+// its palette index.
+func WriteGPL(w io.Writer, palette []canvas.RGB555, name string) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "GIMP Palette\nName: %s\nColumns: 16\n#\n", name)
+	for i, paletteColor := range palette {
+		c := expand(paletteColor)
+		fmt.Fprintf(bw, "%3d %3d %3d\tIndex %d\n", c.R, c.G, c.B, i)
+	}
+	return bw.Flush()
+}
+
+// ReadGPL reads a GIMP palette. Color names are ignored.
+func ReadGPL(r io.Reader) ([]canvas.RGB555, error) {
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 || lines[0] != "GIMP Palette" {
+		return nil, fmt.Errorf("not a GIMP palette file")
+	}
+	var palette []canvas.RGB555
+	for i, line := range lines[1:] {
+		if line == "" || strings.HasPrefix(line, "#") ||
+			strings.HasPrefix(line, "Name:") || strings.HasPrefix(line, "Columns:") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("GIMP palette line %d: expected a color, got %q", i+2, line)
+		}
+		c, err := parseRGB(strings.Join(fields[:3], " "))
+		if err != nil {
+			return nil, fmt.Errorf("GIMP palette line %d: %s", i+2, err)
+		}
+		palette = append(palette, c)
+	}
+	return markTransparent(palette, 0), nil
+}
+
+// WriteACT writes the palette as an Adobe Color Table. ACT files always
+// hold 256 colors, so unused entries are written as black. The file ends
+// with the number of colors, and marks index 0 as transparent.
+func WriteACT(w io.Writer, palette []canvas.RGB555) error {
+	if len(palette) > actColors {
+		return fmt.Errorf("palette has %d colors, but ACT files can only hold %d", len(palette), actColors)
+	}
+	data := make([]byte, actColors*3+4)
+	for i, paletteColor := range palette {
+		c := expand(paletteColor)
+		data[i*3], data[i*3+1], data[i*3+2] = c.R, c.G, c.B
+	}
+	binary.BigEndian.PutUint16(data[actColors*3:], uint16(len(palette)))
+	binary.BigEndian.PutUint16(data[actColors*3+2:], 0)
+	_, err := w.Write(data)
+	return err
+}
+
+// ReadACT reads an Adobe Color Table. If the file has a color count, only
+// that many colors are returned, and the file's transparent index is used
+// instead of index 0. A count of 0 is an empty palette, as written by
+// WriteACT. Without a count, all 256 colors are returned.
+func ReadACT(r io.Reader) ([]canvas.RGB555, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != actColors*3 && len(data) != actColors*3+4 {
+		return nil, fmt.Errorf("ACT file is %d bytes, want %d or %d", len(data), actColors*3, actColors*3+4)
+	}
+	count, transparent := actColors, 0
+	if len(data) == actColors*3+4 {
+		count = int(binary.BigEndian.Uint16(data[actColors*3:]))
+		transparent = int(binary.BigEndian.Uint16(data[actColors*3+2:]))
+		if count > actColors {
+			return nil, fmt.Errorf("invalid ACT color count %d", count)
+		}
+	}
+	palette := make([]canvas.RGB555, count)
+	for i := range palette {
+		palette[i] = convert(data[i*3], data[i*3+1], data[i*3+2])
+	}
+	if transparent == actNoTransparency {
+		return palette, nil
+	}
+	return markTransparent(palette, transparent), nil
+}
+
+// WriteGBA writes the palette as raw GBA BGR555 colors, the format of
+// .gbapal files. Each color is a little-endian 16-bit value, as written by
+// gba.Palette.
+func WriteGBA(w io.Writer, palette []canvas.RGB555) error {
+	_, err := w.Write(gba.Palette(palette))
+	return err
+}
+
+// ReadGBA reads raw GBA BGR555 colors.
+func ReadGBA(r io.Reader) ([]canvas.RGB555, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(data)%2 != 0 {
+		return nil, fmt.Errorf("GBA palette is %d bytes, which is not a whole number of colors", len(data))
+	}
+	palette := make([]canvas.RGB555, len(data)/2)
+	for i := range palette {
+		palette[i] = canvas.FromBGR555(binary.LittleEndian.Uint16(data[i*2:]))
+	}
+	return markTransparent(palette, 0), nil
+}
+
+// expand converts a palette color into the 8-bit color stored in files.
+// Transparent colors keep their RGB value.
+func expand(c canvas.RGB555) color.RGBA {
+	c.A = 255
+	return canvas.ExpandReplicate.Expand(c)
+}
+
+// convert converts an 8-bit color read from a file into an opaque palette
+// color.
+func convert(r, g, b uint8) canvas.RGB555 {
+	opts := canvas.FromImageOptions{ColorConversion: canvas.ConvertGBA}
+	return opts.ConvertColor(color.RGBA{R: r, G: g, B: b, A: 255})
+}
+
+func markTransparent(palette []canvas.RGB555, index int) []canvas.RGB555 {
+	if index >= 0 && index < len(palette) {
+		palette[index].A = 0
+	}
+	return palette
+}
+
+func parseRGB(line string) (canvas.RGB555, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 3 {
+		return canvas.RGB555{}, fmt.Errorf("expected 3 color channels, got %q", line)
+	}
+	var channels [3]uint8
+	for i, field := range fields {
+		value, err := strconv.ParseUint(field, 10, 8)
+		if err != nil {
+			return canvas.RGB555{}, fmt.Errorf("invalid color channel %q", field)
+		}
+		channels[i] = uint8(value)
+	}
+	return convert(channels[0], channels[1], channels[2]), nil
+}
+
+// readLines reads all lines, without their CR or LF line endings.
+func readLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimRight(scanner.Text(), "\r"))
+	}
+	return lines, scanner.Err()
+}
@@ -0,0 +1,99 @@
+package palfile
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+)
+
+func testPalette() []canvas.RGB555 {
+	palette := []canvas.RGB555{{}}
+	for i := 0; i < 32; i++ {
+		palette = append(palette, canvas.RGB555{R: uint8(i), G: uint8(31 - i), B: uint8(i * 7 % 32), A: 255})
+	}
+	return palette
+}
+
+func TestRoundTrip(t *testing.T) {
+	formats := []struct {
+		name  string
+		write func(io.Writer, []canvas.RGB555) error
+		read  func(io.Reader) ([]canvas.RGB555, error)
+	}{
+		{"JASC", WriteJASC, ReadJASC},
+		{"GPL", func(w io.Writer, p []canvas.RGB555) error { return WriteGPL(w, p, "test") }, ReadGPL},
+		{"ACT", WriteACT, ReadACT},
+		{"GBA", WriteGBA, ReadGBA},
+	}
+	for _, palette := range [][]canvas.RGB555{testPalette(), {}} {
+		for _, format := range formats {
+			var buf bytes.Buffer
+			if err := format.write(&buf, palette); err != nil {
+				t.Errorf("%s, %d colors: write: %s", format.name, len(palette), err)
+				continue
+			}
+			got, err := format.read(&buf)
+			if err != nil {
+				t.Errorf("%s, %d colors: read: %s", format.name, len(palette), err)
+				continue
+			}
+			if len(got) != len(palette) || len(got) > 0 && !reflect.DeepEqual(got, palette) {
+				t.Errorf("%s, %d colors: read back %v, want %v", format.name, len(palette), got, palette)
+			}
+		}
+	}
+}
+
+func TestWriteJASC(t *testing.T) {
+	var buf bytes.Buffer
+	palette := []canvas.RGB555{{R: 1, G: 2, B: 3}, {R: 31, G: 16, B: 0, A: 255}}
+	if err := WriteJASC(&buf, palette); err != nil {
+		t.Fatal(err)
+	}
+	want := "JASC-PAL\r\n0100\r\n2\r\n8 16 24\r\n255 132 0\r\n"
+	if got := buf.String(); got != want {
+		t.Errorf("WriteJASC wrote %q, want %q", got, want)
+	}
+}
+
+func TestReadGPL(t *testing.T) {
+	gpl := "GIMP Palette\nName: Sprite\nColumns: 4\n# comment\n0 0 0 Background\n255 255 255\tWhite\n"
+	got, err := ReadGPL(strings.NewReader(gpl))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []canvas.RGB555{{}, {R: 31, G: 31, B: 31, A: 255}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadGPL = %v, want %v", got, want)
+	}
+}
+
+func TestReadACTTransparency(t *testing.T) {
+	data := make([]byte, 772)
+	data[3*3] = 255
+	data[769] = 4
+	data[770], data[771] = 0xFF, 0xFF
+	got, err := ReadACT(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 4 {
+		t.Fatalf("ReadACT returned %d colors, want 4", len(got))
+	}
+	for i, c := range got {
+		if c.A != 255 {
+			t.Errorf("color %d is transparent, but the file has no transparent index", i)
+		}
+	}
+	if got[3] != (canvas.RGB555{R: 31, A: 255}) {
+		t.Errorf("color 3 = %v, want red", got[3])
+	}
+
+	if err := WriteACT(&bytes.Buffer{}, make([]canvas.RGB555, 257)); err == nil {
+		t.Errorf("WriteACT accepted a palette with 257 colors")
+	}
+}
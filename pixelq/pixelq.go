@@ -1,14 +1,16 @@
 package pixelq
 
 import (
-	"image/color"
+	"fmt"
+	"image"
+	"math/rand"
 
 	"github.com/huderlem/contest-painting-effects/canvas"
 )
 
 // Invert returns the pixel color. Also called the "negative".
-func Invert(pixel color.RGBA) color.RGBA {
-	return color.RGBA{
+func Invert(pixel canvas.RGB555) canvas.RGB555 {
+	return canvas.RGB555{
 		R: 31 - pixel.R,
 		G: 31 - pixel.G,
 		B: 31 - pixel.B,
@@ -17,7 +19,7 @@ func Invert(pixel color.RGBA) color.RGBA {
 }
 
 // Blur returns a blurred version of the given 3 pixels.
-func Blur(prevPixel, curPixel, nextPixel color.RGBA) color.RGBA {
+func Blur(prevPixel, curPixel, nextPixel canvas.RGB555) canvas.RGB555 {
 	if prevPixel == curPixel && nextPixel == curPixel {
 		return curPixel
 	}
@@ -46,7 +48,7 @@ func Blur(prevPixel, curPixel, nextPixel color.RGBA) color.RGBA {
 	}
 
 	factor := 31 - diff/2
-	return color.RGBA{
+	return canvas.RGB555{
 		R: uint8((int(curPixel.R) * factor) / 31),
 		G: uint8((int(curPixel.G) * factor) / 31),
 		B: uint8((int(curPixel.B) * factor) / 31),
@@ -55,7 +57,7 @@ func Blur(prevPixel, curPixel, nextPixel color.RGBA) color.RGBA {
 }
 
 // BlurHard returns a harder-blurred version of the given 3 pixels.
-func BlurHard(prevPixel, curPixel, nextPixel color.RGBA) color.RGBA {
+func BlurHard(prevPixel, curPixel, nextPixel canvas.RGB555) canvas.RGB555 {
 	if prevPixel == curPixel && nextPixel == curPixel {
 		return curPixel
 	}
@@ -84,7 +86,7 @@ func BlurHard(prevPixel, curPixel, nextPixel color.RGBA) color.RGBA {
 	}
 
 	factor := 31 - diff
-	return color.RGBA{
+	return canvas.RGB555{
 		R: uint8((int(curPixel.R) * factor) / 31),
 		G: uint8((int(curPixel.G) * factor) / 31),
 		B: uint8((int(curPixel.B) * factor) / 31),
@@ -93,7 +95,7 @@ func BlurHard(prevPixel, curPixel, nextPixel color.RGBA) color.RGBA {
 }
 
 // MotionBlur returns a blurred version of the given 2 pixels.
-func MotionBlur(prevPixel, curPixel color.RGBA) color.RGBA {
+func MotionBlur(prevPixel, curPixel canvas.RGB555) canvas.RGB555 {
 	if prevPixel == curPixel {
 		return curPixel
 	}
@@ -128,7 +130,7 @@ func MotionBlur(prevPixel, curPixel color.RGBA) color.RGBA {
 	}
 
 	factor := 31 - diff/2
-	return color.RGBA{
+	return canvas.RGB555{
 		R: uint8((int(curPixel.R) * factor) / 31),
 		G: uint8((int(curPixel.G) * factor) / 31),
 		B: uint8((int(curPixel.B) * factor) / 31),
@@ -137,29 +139,29 @@ func MotionBlur(prevPixel, curPixel color.RGBA) color.RGBA {
 }
 
 // BlackOutline returns a border-colored pixel if pixelA is a border pixel.
-func BlackOutline(pixelA, pixelB color.RGBA) color.RGBA {
+func BlackOutline(pixelA, pixelB canvas.RGB555) canvas.RGB555 {
 	if pixelA.R == 0 && pixelA.G == 0 && pixelA.B == 0 && pixelA.A == 255 {
 		return pixelA
 	}
 	if pixelA.A != 255 {
-		return color.RGBA{0, 0, 0, 0}
+		return canvas.RGB555{R: 0, G: 0, B: 0, A: 0}
 	}
 	if pixelB.A != 255 {
-		return color.RGBA{0, 0, 0, 255}
+		return canvas.RGB555{R: 0, G: 0, B: 0, A: 255}
 	}
 	return pixelA
 }
 
 // PersonalityColor returns a color determined by the personality value of a pokemon. (only uses lower 8 bytes, which is why)
 // personality is a uint8. Returns white if the pixel is light.
-func PersonalityColor(pixel color.RGBA, personality uint8) color.RGBA {
+func PersonalityColor(pixel canvas.RGB555, personality uint8) canvas.RGB555 {
 	if pixel.R < 17 && pixel.G < 17 && pixel.B < 17 {
 		return getColorFromPersonality(personality)
 	}
-	return color.RGBA{31, 31, 31, 255}
+	return canvas.RGB555{R: 31, G: 31, B: 31, A: 255}
 }
 
-func getColorFromPersonality(personality uint8) color.RGBA {
+func getColorFromPersonality(personality uint8) canvas.RGB555 {
 	var red, green, blue uint8 = 0, 0, 0
 	strength := (personality / 6) % 3
 
@@ -195,15 +197,15 @@ func getColorFromPersonality(personality uint8) color.RGBA {
 		green = 23 - strength
 		red = 0
 	}
-	return color.RGBA{red, green, blue, 255}
+	return canvas.RGB555{R: red, G: green, B: blue, A: 255}
 }
 
 // BlackAndWhite converts a pixel to black or white.
-func BlackAndWhite(pixel color.RGBA) color.RGBA {
+func BlackAndWhite(pixel canvas.RGB555) canvas.RGB555 {
 	if pixel.R < 17 && pixel.G < 17 && pixel.B < 17 {
-		return color.RGBA{0, 0, 0, 255}
+		return canvas.RGB555{R: 0, G: 0, B: 0, A: 255}
 	}
-	return color.RGBA{31, 31, 31, 255}
+	return canvas.RGB555{R: 31, G: 31, B: 31, A: 255}
 }
 
 type pointillismPoint struct {
@@ -212,19 +214,110 @@ type pointillismPoint struct {
 	delta  uint8
 }
 
+// NumPointillismPoints is the number of points in the game's pointillism
+// table.
+const NumPointillismPoints = 3200
+
+// PointillismTileSize is the width and height of the area covered by a
+// pointillism table. The points are drawn on each whole tile of a region.
+const PointillismTileSize = 64
+
+// maxPointillismDots is the largest number of dots a point can draw.
+const maxPointillismDots = 6
+
+// PointillismTable returns a copy of the game's pointillism table. Each
+// point is 3 bytes: the column and row of the first dot, relative to the
+// top-left corner of a 64x64 area, and a byte holding the number of dots
+// (bits 3-5), the color change (bits 1-2) and the line direction (bit 0).
+func PointillismTable() []uint8 {
+	return append([]uint8(nil), pointillism...)
+}
+
+// GeneratePointillismTable generates a pointillism table with the given
+// number of points, in the same format as the game's table. Its values
+// follow the same ranges as the game's table: 2 to 6 dots per point, and
+// every color change and direction. The same seed always generates the
+// same table. A negative numPoints generates an empty table.
+func GeneratePointillismTable(seed int64, numPoints int) []uint8 {
+	if numPoints < 0 {
+		numPoints = 0
+	}
+	rng := rand.New(rand.NewSource(seed))
+	table := make([]uint8, 0, numPoints*3)
+	for i := 0; i < numPoints; i++ {
+		column := uint8(rng.Intn(PointillismTileSize))
+		row := uint8(rng.Intn(PointillismTileSize))
+		delta := uint8(2 + rng.Intn(5))
+		colorType := uint8(rng.Intn(4))
+		direction := uint8(rng.Intn(2))
+		table = append(table, column, row, delta<<3|colorType<<1|direction)
+	}
+	return table
+}
+
+// ValidatePointillismTable checks that a pointillism table can be used
+// with AddPointillismPointsFromTable. The table must hold a whole number of
+// points, and each point must start inside the 64x64 tile and draw 1 to 6
+// dots.
+func ValidatePointillismTable(table []uint8) error {
+	if len(table)%3 != 0 {
+		return fmt.Errorf("pointillism table is %d bytes, which is not a whole number of 3-byte points", len(table))
+	}
+	for point := 0; point < len(table)/3; point++ {
+		if err := checkPointillismPoint(table, point); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkPointillismPoint(table []uint8, point int) error {
+	index := point * 3
+	if point < 0 || index+3 > len(table) {
+		return fmt.Errorf("point %d is outside the %d-point pointillism table", point, len(table)/3)
+	}
+	if table[index] >= PointillismTileSize || table[index+1] >= PointillismTileSize {
+		return fmt.Errorf("pointillism point %d starts at (%d, %d), outside the %dx%d tile", point, table[index], table[index+1], PointillismTileSize, PointillismTileSize)
+	}
+	if dots := table[index+2] >> 3 & 7; dots < 1 || dots > maxPointillismDots {
+		return fmt.Errorf("pointillism point %d has %d dots, which is out of range 1-%d", point, dots, maxPointillismDots)
+	}
+	return nil
+}
+
 // AddPointillismPoints splats dots onto the canvas to give
-// a pointillism effect.
-func AddPointillismPoints(c canvas.Canvas, point int) {
-	for cx := 0; cx < c.Width()/64; cx++ {
-		for cy := 0; cy < c.Height()/64; cy++ {
-			index := point * 3
-			points := make([]pointillismPoint, 6)
-			points[0].column = int(pointillism[index]) + cx*64
-			points[0].row = int(pointillism[index+1]) + cy*64
-			points[0].delta = (pointillism[index+2] >> 3) & 7
+// a pointillism effect. The dots are placed relative to the
+// top-left corner of each whole 64x64 tile of the region, so
+// regions smaller than a tile get no dots.
+func AddPointillismPoints(c canvas.Canvas, point int, region ...image.Rectangle) {
+	addPointillismPoints(c, pointillism, point, c.Region(region...))
+}
+
+// AddPointillismPointsFromTable is like AddPointillismPoints, but reads the
+// point from the given pointillism table instead of the game's table. It
+// returns an error, and draws nothing, if the point is not in the table or
+// is not valid according to ValidatePointillismTable.
+func AddPointillismPointsFromTable(c canvas.Canvas, table []uint8, point int, region ...image.Rectangle) error {
+	if err := checkPointillismPoint(table, point); err != nil {
+		return err
+	}
+	addPointillismPoints(c, table, point, c.Region(region...))
+	return nil
+}
 
-			colorType := (pointillism[index+2] >> 1) & 3
-			offsetDownLeft := pointillism[index+2] & 1
+func addPointillismPoints(c canvas.Canvas, table []uint8, point int, r image.Rectangle) {
+	for cx := 0; cx < r.Dx()/PointillismTileSize; cx++ {
+		for cy := 0; cy < r.Dy()/PointillismTileSize; cy++ {
+			index := point * 3
+			left := r.Min.X + cx*PointillismTileSize
+			top := r.Min.Y + cy*PointillismTileSize
+			points := make([]pointillismPoint, maxPointillismDots)
+			points[0].column = int(table[index]) + left
+			points[0].row = int(table[index+1]) + top
+			points[0].delta = (table[index+2] >> 3) & 7
+
+			colorType := (table[index+2] >> 1) & 3
+			offsetDownLeft := table[index+2] & 1
 			for i := uint8(1); i < points[0].delta; i++ {
 				if offsetDownLeft == 0 {
 					points[i].column = points[0].column - int(i)
@@ -233,7 +326,11 @@ func AddPointillismPoints(c canvas.Canvas, point int) {
 					points[i].column = points[0].column + 1
 					points[i].row = points[0].row - 1
 				}
-				if int(points[i].column) > c.Width()-1 || int(points[i].row) > c.Height()-1 {
+				// The game stores the point coordinates as unsigned bytes, so
+				// points moving past the top or left edge wrap around, and end
+				// the line the same way as points past the bottom or right edge.
+				if points[i].column < left || points[i].row < top ||
+					points[i].column > r.Max.X-1 || points[i].row > r.Max.Y-1 {
 					points[0].delta = i - 1
 					break
 				}
@@ -243,14 +340,14 @@ func AddPointillismPoints(c canvas.Canvas, point int) {
 
 			for i := uint8(0); i < points[0].delta; i++ {
 				x, y := int(points[i].column), int(points[i].row)
-				pixel := c.At(x, y)
+				pixel := c.Pixel(x, y)
 				if pixel.A == 255 {
 					red := pixel.R
 					green := pixel.G
 					blue := pixel.B
 					switch colorType {
 					case 0, 1:
-						switch ((pointillism[index+2] >> 3) & 7) % 3 {
+						switch ((table[index+2] >> 3) & 7) % 3 {
 						case 0:
 							if red >= points[i].delta {
 								red -= points[i].delta
@@ -285,7 +382,7 @@ func AddPointillismPoints(c canvas.Canvas, point int) {
 						}
 					}
 
-					c.Set(x, y, color.RGBA{red, green, blue, pixel.A})
+					c.SetPixel(x, y, canvas.RGB555{R: red, G: green, B: blue, A: pixel.A})
 				}
 			}
 		}
@@ -0,0 +1,94 @@
+package pixelq
+
+import (
+	"bytes"
+	"image"
+	"testing"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+)
+
+func TestPointillismTable(t *testing.T) {
+	table := PointillismTable()
+	if len(table) != NumPointillismPoints*3 {
+		t.Fatalf("table has %d bytes, want %d", len(table), NumPointillismPoints*3)
+	}
+	table[0]++
+	if bytes.Equal(table, PointillismTable()) {
+		t.Errorf("changing the returned table changed the game's table")
+	}
+}
+
+func TestGeneratePointillismTable(t *testing.T) {
+	table := GeneratePointillismTable(42, NumPointillismPoints)
+	if len(table) != NumPointillismPoints*3 {
+		t.Fatalf("table has %d bytes, want %d", len(table), NumPointillismPoints*3)
+	}
+	if !bytes.Equal(table, GeneratePointillismTable(42, NumPointillismPoints)) {
+		t.Errorf("the same seed generated different tables")
+	}
+	if bytes.Equal(table, GeneratePointillismTable(43, NumPointillismPoints)) {
+		t.Errorf("different seeds generated the same table")
+	}
+	for i := 0; i < len(table); i += 3 {
+		delta := table[i+2] >> 3 & 7
+		if table[i] > 63 || table[i+1] > 63 || delta < 2 || delta > 6 || table[i+2]>>6 != 0 {
+			t.Fatalf("point %d is out of range: % x", i/3, table[i:i+3])
+		}
+	}
+	for _, numPoints := range []int{0, -1} {
+		if table := GeneratePointillismTable(42, numPoints); len(table) != 0 {
+			t.Errorf("%d points generated a table with %d bytes, want 0", numPoints, len(table))
+		}
+	}
+}
+
+func TestValidatePointillismTable(t *testing.T) {
+	if err := ValidatePointillismTable(PointillismTable()); err != nil {
+		t.Errorf("game's table: got error %v", err)
+	}
+	if err := ValidatePointillismTable(GeneratePointillismTable(1, 100)); err != nil {
+		t.Errorf("generated table: got error %v", err)
+	}
+	for _, table := range [][]uint8{
+		{10, 10, 7 << 3},
+		{10, 10, 0},
+		{64, 10, 2 << 3},
+		{10, 64, 2 << 3},
+		{10, 10, 2 << 3, 10},
+	} {
+		if err := ValidatePointillismTable(table); err == nil {
+			t.Errorf("table % x: got no error", table)
+		}
+	}
+}
+
+func TestAddPointillismPointsFromTableMalformed(t *testing.T) {
+	fill := canvas.RGB555{R: 20, G: 20, B: 20, A: 255}
+	for _, tt := range []struct {
+		table []uint8
+		point int
+	}{
+		{[]uint8{10, 10, 7 << 3}, 0},
+		{[]uint8{10, 10, 2 << 3}, 1},
+		{[]uint8{10, 10, 2 << 3}, -1},
+		{[]uint8{200, 10, 2 << 3}, 0},
+	} {
+		c := canvas.New(128, 64)
+		for y := 0; y < 64; y++ {
+			for x := 0; x < 128; x++ {
+				c.SetPixel(x, y, fill)
+			}
+		}
+		if err := AddPointillismPointsFromTable(c, tt.table, tt.point, image.Rect(0, 0, 64, 64)); err == nil {
+			t.Errorf("table % x, point %d: got no error", tt.table, tt.point)
+		}
+		for y := 0; y < 64; y++ {
+			for x := 0; x < 128; x++ {
+				if c.Pixel(x, y) != fill {
+					t.Fatalf("table % x, point %d: pixel (%d, %d) changed", tt.table, tt.point, x, y)
+				}
+			}
+		}
+	}
+}
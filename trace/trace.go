@@ -0,0 +1,210 @@
+// Package trace records the canvas after each step of a painting, and
+// renders the steps as an animated GIF or a labeled contact sheet, to show
+// how each effect changes the painting.
+package trace
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"io"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+)
+
+// ErrNoSteps is returned when rendering a trace without any steps.
+var ErrNoSteps = errors.New("trace has no steps")
+
+// margin is the space around each rendered step, in pixels.
+const margin = 4
+
+// Step is a snapshot of the canvas after one step of a painting.
+type Step struct {
+	Name   string
+	Canvas canvas.Canvas
+	// Palette is the quantized palette for quantization steps, whose
+	// canvas is drawn with its color indexes. It is nil for effect steps,
+	// whose canvas is drawn with its pixel colors.
+	Palette []canvas.RGB555
+}
+
+// Trace is a sequence of canvas snapshots. The zero value is an empty trace.
+type Trace struct {
+	Steps []Step
+}
+
+// Record adds a snapshot of the canvas pixel colors.
+func (t *Trace) Record(name string, c canvas.Canvas) {
+	t.Steps = append(t.Steps, Step{Name: name, Canvas: c.Clone()})
+}
+
+// RecordQuantization adds a snapshot of the canvas color indexes, which are
+// drawn with the quantized palette.
+func (t *Trace) RecordQuantization(name string, c canvas.Canvas, palette []canvas.RGB555) {
+	t.Steps = append(t.Steps, Step{
+		Name:    name,
+		Canvas:  c.Clone(),
+		Palette: append([]canvas.RGB555(nil), palette...),
+	})
+}
+
+// Image returns the step's canvas as an image, with the same bounds as the
+// canvas.
+func (s *Step) Image() *image.RGBA {
+	if s.Palette != nil {
+		return s.Canvas.ToImageWithOptions(s.Palette, canvas.ToImageOptions{})
+	}
+	img := image.NewRGBA(s.Canvas.Bounds())
+	draw.Draw(img, img.Bounds(), &s.Canvas, img.Bounds().Min, draw.Src)
+	return img
+}
+
+// RenderOptions configures how a trace is rendered.
+type RenderOptions struct {
+	// Scale is how many times each canvas is enlarged. Defaults to 2.
+	Scale int
+	// Background is drawn behind transparent pixels and labels. Defaults
+	// to light gray.
+	Background color.Color
+	// Delay is how long each GIF frame is shown, in hundredths of a
+	// second. Defaults to 100.
+	Delay int
+	// Columns is the number of steps in each row of a contact sheet.
+	// Defaults to 4.
+	Columns int
+}
+
+func (opts RenderOptions) withDefaults() RenderOptions {
+	if opts.Scale <= 0 {
+		opts.Scale = 2
+	}
+	if opts.Background == nil {
+		opts.Background = color.RGBA{0xD0, 0xD0, 0xD0, 0xFF}
+	}
+	if opts.Delay <= 0 {
+		opts.Delay = 100
+	}
+	if opts.Columns <= 0 {
+		opts.Columns = 4
+	}
+	return opts
+}
+
+// EncodeGIF writes the steps as a looping animated GIF, with one labeled
+// frame per step.
+func (t *Trace) EncodeGIF(w io.Writer, opts RenderOptions) error {
+	opts = opts.withDefaults()
+	frames, err := t.frames(opts)
+	if err != nil {
+		return err
+	}
+	anim := &gif.GIF{}
+	for _, frame := range frames {
+		anim.Image = append(anim.Image, toPaletted(frame))
+		anim.Delay = append(anim.Delay, opts.Delay)
+	}
+	return gif.EncodeAll(w, anim)
+}
+
+// EncodeContactSheet writes the steps as a PNG, with the labeled steps laid
+// out in rows from left to right.
+func (t *Trace) EncodeContactSheet(w io.Writer, opts RenderOptions) error {
+	opts = opts.withDefaults()
+	frames, err := t.frames(opts)
+	if err != nil {
+		return err
+	}
+	columns := opts.Columns
+	if len(frames) < columns {
+		columns = len(frames)
+	}
+	rows := (len(frames) + columns - 1) / columns
+	cell := frames[0].Bounds().Size()
+	sheet := image.NewRGBA(image.Rect(0, 0, columns*cell.X, rows*cell.Y))
+	draw.Draw(sheet, sheet.Bounds(), image.NewUniform(opts.Background), image.Point{}, draw.Src)
+	for i, frame := range frames {
+		at := image.Pt(i%columns*cell.X, i/columns*cell.Y)
+		draw.Draw(sheet, frame.Bounds().Add(at), frame, image.Point{}, draw.Src)
+	}
+	return png.Encode(w, sheet)
+}
+
+// frames renders each step on its own background, with the enlarged canvas
+// at the top and its label below. All frames have the same size.
+func (t *Trace) frames(opts RenderOptions) ([]*image.RGBA, error) {
+	if len(t.Steps) == 0 {
+		return nil, ErrNoSteps
+	}
+	labels := make([]string, len(t.Steps))
+	width, height := 0, 0
+	for i, step := range t.Steps {
+		labels[i] = fmt.Sprintf("%d. %s", i, step.Name)
+		if w := textWidth(labels[i]); w > width {
+			width = w
+		}
+		size := step.Canvas.Bounds().Size().Mul(opts.Scale)
+		if size.X > width {
+			width = size.X
+		}
+		if size.Y > height {
+			height = size.Y
+		}
+	}
+	bounds := image.Rect(0, 0, width+2*margin, height+glyphHeight+3*margin)
+
+	frames := make([]*image.RGBA, len(t.Steps))
+	for i := range t.Steps {
+		frame := image.NewRGBA(bounds)
+		draw.Draw(frame, bounds, image.NewUniform(opts.Background), image.Point{}, draw.Src)
+		img := scale(t.Steps[i].Image(), opts.Scale)
+		at := image.Pt((bounds.Dx()-img.Bounds().Dx())/2, margin)
+		draw.Draw(frame, img.Bounds().Sub(img.Bounds().Min).Add(at), img, img.Bounds().Min, draw.Over)
+		labelAt := image.Pt((bounds.Dx()-textWidth(labels[i]))/2, height+2*margin)
+		drawText(frame, labelAt, labels[i], color.RGBA{0x20, 0x20, 0x20, 0xFF})
+		frames[i] = frame
+	}
+	return frames, nil
+}
+
+// scale enlarges an image by an integer factor, without smoothing.
+func scale(img *image.RGBA, factor int) *image.RGBA {
+	b := img.Bounds()
+	scaled := image.NewRGBA(image.Rect(0, 0, b.Dx()*factor, b.Dy()*factor))
+	for y := 0; y < scaled.Bounds().Dy(); y++ {
+		for x := 0; x < scaled.Bounds().Dx(); x++ {
+			scaled.SetRGBA(x, y, img.RGBAAt(b.Min.X+x/factor, b.Min.Y+y/factor))
+		}
+	}
+	return scaled
+}
+
+// toPaletted converts a frame for GIF encoding. Frames with at most 256
+// colors keep their exact colors. Larger frames are dithered with a
+// standard palette.
+func toPaletted(img *image.RGBA) *image.Paletted {
+	var colors color.Palette
+	seen := make(map[color.RGBA]bool)
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y && len(colors) <= 256; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := img.RGBAAt(x, y)
+			if !seen[c] {
+				seen[c] = true
+				colors = append(colors, c)
+			}
+		}
+	}
+	if len(colors) > 256 {
+		paletted := image.NewPaletted(b, palette.Plan9)
+		draw.FloydSteinberg.Draw(paletted, b, img, b.Min)
+		return paletted
+	}
+	paletted := image.NewPaletted(b, colors)
+	draw.Draw(paletted, b, img, b.Min, draw.Src)
+	return paletted
+}
@@ -0,0 +1,78 @@
+package trace
+
+import (
+	"bytes"
+	"image"
+	"image/gif"
+	"image/png"
+	"testing"
+
+	"github.com/huderlem/contest-painting-effects/canvas"
+)
+
+func testTrace() *Trace {
+	c := canvas.New(8, 8)
+	c.SetPixel(1, 1, canvas.RGB555{R: 31, A: 255})
+	t := &Trace{}
+	t.Record("Original", c)
+	c.SetPixel(2, 2, canvas.RGB555{G: 31, A: 255})
+	t.Record("Green dot", c)
+	c.SetColorIndex(1, 1, 1)
+	c.SetColorIndex(2, 2, 2)
+	t.RecordQuantization("Quantization", c, []canvas.RGB555{{}, {B: 31, A: 255}, {R: 31, G: 31, A: 255}})
+	return t
+}
+
+func TestRecord(t *testing.T) {
+	trace := testTrace()
+	if len(trace.Steps) != 3 {
+		t.Fatalf("trace has %d steps, want 3", len(trace.Steps))
+	}
+	if got := trace.Steps[0].Canvas.Pixel(2, 2); got != (canvas.RGB555{}) {
+		t.Errorf("changing the canvas changed an earlier snapshot: %v", got)
+	}
+	first := trace.Steps[0].Image().RGBAAt(1, 1)
+	quantized := trace.Steps[2].Image().RGBAAt(1, 1)
+	if first.R != 248 || quantized.B != 248 {
+		t.Errorf("step images have colors %v and %v, want red and the blue palette color", first, quantized)
+	}
+}
+
+func TestEncodeGIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testTrace().EncodeGIF(&buf, RenderOptions{Delay: 50}); err != nil {
+		t.Fatal(err)
+	}
+	anim, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(anim.Image) != 3 {
+		t.Fatalf("GIF has %d frames, want 3", len(anim.Image))
+	}
+	for i, delay := range anim.Delay {
+		if delay != 50 {
+			t.Errorf("frame %d delay is %d, want 50", i, delay)
+		}
+	}
+}
+
+func TestEncodeContactSheet(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testTrace().EncodeContactSheet(&buf, RenderOptions{Columns: 2}); err != nil {
+		t.Fatal(err)
+	}
+	sheet, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each cell is as wide as the longest label, "2. Quantization".
+	cell := image.Pt(textWidth("2. Quantization")+2*margin, 8*2+glyphHeight+3*margin)
+	if want := image.Rect(0, 0, 2*cell.X, 2*cell.Y); sheet.Bounds() != want {
+		t.Errorf("contact sheet bounds are %v, want %v", sheet.Bounds(), want)
+	}
+
+	if err := (&Trace{}).EncodeContactSheet(&buf, RenderOptions{}); err != ErrNoSteps {
+		t.Errorf("empty trace: got error %v, want ErrNoSteps", err)
+	}
+}